@@ -0,0 +1,183 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cpu
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// CacheType is the kind of data held by a cache.
+type CacheType int
+
+const (
+	CacheUnknown     CacheType = iota
+	CacheData                  // Data cache
+	CacheInstruction           // Instruction cache
+	CacheUnified               // Unified data and instruction cache
+)
+
+func (t CacheType) String() string {
+	switch t {
+	case CacheData:
+		return "data"
+	case CacheInstruction:
+		return "instruction"
+	case CacheUnified:
+		return "unified"
+	}
+	return "unknown"
+}
+
+// Cache describes a processor cache. Fields that cannot be determined are
+// left zero.
+type Cache struct {
+	Level    int       // Cache level, 1 for the cache closest to the core
+	Type     CacheType // Kind of data held by the cache
+	Size     int       // Total size in bytes
+	LineSize int       // Line size in bytes
+	Ways     int       // Associativity; a fully associative cache has one way per line
+	SharedBy int       // Number of logical processors sharing the cache
+}
+
+// Caches returns the caches of the processor the program is running on,
+// ordered by level. It returns nil if they cannot be determined.
+//
+// On x86 the caches are enumerated with CPUID on all operating systems and
+// SharedBy is the maximum number of logical processors that may share the
+// cache, which can exceed the number actually present. On other
+// architectures the caches are read from sysfs on Linux; if that fails on
+// arm64, only the level 1 line sizes are reported, from the CTR_EL0
+// register. On systems with processors of several kinds, such as big.LITTLE
+// arm64 systems, the caches may be those of any one of them.
+func Caches() []Cache {
+	return slices.Clone(caches())
+}
+
+var caches = sync.OnceValue(func() []Cache {
+	c := readCaches()
+	slices.SortStableFunc(c, func(a, b Cache) int { return a.Level - b.Level })
+	return c
+})
+
+// CacheLineSize returns the line size in bytes of the level 1 data cache of
+// the processor the program is running on. If it cannot be determined, it
+// returns the size of CacheLinePad.
+func CacheLineSize() int {
+	for _, c := range caches() {
+		if c.Level == 1 && (c.Type == CacheData || c.Type == CacheUnified) && c.LineSize > 0 {
+			return c.LineSize
+		}
+	}
+	return cacheLineSize
+}
+
+// CoreType is the kind of a core in a processor that has cores of several
+// kinds, such as Intel processors with performance and efficiency cores.
+type CoreType int
+
+const (
+	CoreTypeUnknown     CoreType = iota
+	CoreTypePerformance          // Performance core, e.g. Intel Core
+	CoreTypeEfficiency           // Efficiency core, e.g. Intel Atom
+)
+
+func (t CoreType) String() string {
+	switch t {
+	case CoreTypePerformance:
+		return "performance"
+	case CoreTypeEfficiency:
+		return "efficiency"
+	}
+	return "unknown"
+}
+
+// CurrentCoreType returns the kind of the core the calling thread is
+// running on. It returns CoreTypeUnknown if the processor does not have
+// cores of several kinds or if the kind cannot be determined, which is
+// currently the case everywhere but on Intel hybrid processors.
+//
+// The thread may migrate to another core at any time, so the result is
+// only a hint unless the thread is locked with runtime.LockOSThread and
+// its CPU affinity restricts it to cores of one kind.
+func CurrentCoreType() CoreType {
+	return currentCoreType()
+}
+
+// decodeCacheLeaf decodes the description of a cache returned by CPUID
+// leaf 4 on Intel and leaf 0x8000001D on AMD, which share a format. It
+// returns false when there are no more caches.
+func decodeCacheLeaf(eax, ebx, ecx uint32) (Cache, bool) {
+	typ := CacheType(eax & 0x1f)
+	if typ < CacheData || typ > CacheUnified {
+		return Cache{}, false
+	}
+	lineSize := int(ebx&0xfff) + 1
+	partitions := int(ebx>>12&0x3ff) + 1
+	ways := int(ebx>>22&0x3ff) + 1
+	sets := int(ecx) + 1
+	c := Cache{
+		Level:    int(eax>>5) & 0x7,
+		Type:     typ,
+		Size:     ways * partitions * lineSize * sets,
+		LineSize: lineSize,
+		Ways:     ways,
+		SharedBy: int(eax>>14&0xfff) + 1,
+	}
+	if eax&(1<<9) != 0 {
+		// Fully associative: the ways and sets fields are meaningless.
+		c.Ways = c.Size / lineSize
+	}
+	return c, true
+}
+
+// parseCacheSize parses a cache size as found in sysfs, such as "32K".
+func parseCacheSize(s string) (int, bool) {
+	s = strings.TrimSpace(s)
+	shift := 0
+	switch {
+	case strings.HasSuffix(s, "K"):
+		shift = 10
+	case strings.HasSuffix(s, "M"):
+		shift = 20
+	case strings.HasSuffix(s, "G"):
+		shift = 30
+	}
+	if shift > 0 {
+		s = s[:len(s)-1]
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	return n << shift, true
+}
+
+// countCPUList returns the number of CPUs in a CPU list as found in sysfs,
+// such as "0-3,8-11".
+func countCPUList(s string) (int, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, false
+	}
+	count := 0
+	for r := range strings.SplitSeq(s, ",") {
+		lo, hi, isRange := strings.Cut(r, "-")
+		first, err := strconv.Atoi(lo)
+		if err != nil {
+			return 0, false
+		}
+		last := first
+		if isRange {
+			if last, err = strconv.Atoi(hi); err != nil || last < first {
+				return 0, false
+			}
+		}
+		count += last - first + 1
+	}
+	return count, true
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux && !386 && !amd64 && !amd64p32
+
+package cpu
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+func readCaches() []Cache {
+	if c := readSysfsCaches(); c != nil {
+		return c
+	}
+	return archCaches()
+}
+
+// readSysfsCaches reads the caches of the first CPU from sysfs.
+func readSysfsCaches() []Cache {
+	const dir = "/sys/devices/system/cpu/cpu0/cache/index"
+	var caches []Cache
+	for i := 0; ; i++ {
+		index := dir + strconv.Itoa(i) + "/"
+		level, ok := readSysfsInt(index + "level")
+		if !ok {
+			break
+		}
+		c := Cache{Level: level}
+		switch readSysfsString(index + "type") {
+		case "Data":
+			c.Type = CacheData
+		case "Instruction":
+			c.Type = CacheInstruction
+		case "Unified":
+			c.Type = CacheUnified
+		}
+		c.Size, _ = parseCacheSize(readSysfsString(index + "size"))
+		c.LineSize, _ = readSysfsInt(index + "coherency_line_size")
+		c.Ways, _ = readSysfsInt(index + "ways_of_associativity")
+		c.SharedBy, _ = countCPUList(readSysfsString(index + "shared_cpu_list"))
+		caches = append(caches, c)
+	}
+	return caches
+}
+
+func readSysfsString(name string) string {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
+
+func readSysfsInt(name string) (int, bool) {
+	n, err := strconv.Atoi(readSysfsString(name))
+	return n, err == nil
+}
+
+func currentCoreType() CoreType {
+	return CoreTypeUnknown
+}
@@ -0,0 +1,19 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cpu
+
+// archCaches returns the level 1 caches described by the Cache Type
+// Register, which Linux lets user space read. CTR_EL0 only holds the
+// smallest line sizes of the data and instruction caches.
+func archCaches() []Cache {
+	ctr := getctr()
+	if ctr == 0 {
+		return nil
+	}
+	return []Cache{
+		{Level: 1, Type: CacheData, LineSize: 4 << (ctr >> 16 & 0xf)},
+		{Level: 1, Type: CacheInstruction, LineSize: 4 << (ctr & 0xf)},
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !linux && !386 && !amd64 && !amd64p32
+
+package cpu
+
+func readCaches() []Cache { return nil }
+
+func currentCoreType() CoreType { return CoreTypeUnknown }
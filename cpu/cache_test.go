@@ -0,0 +1,70 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cpu
+
+import "testing"
+
+func TestDecodeCacheLeaf(t *testing.T) {
+	for _, tt := range []struct {
+		eax, ebx, ecx uint32
+		want          Cache
+		ok            bool
+	}{
+		// 48K 12-way L1 data cache shared by 2 threads.
+		{0x00004121, 0x02c0003f, 0x3f, Cache{1, CacheData, 48 << 10, 64, 12, 2}, true},
+		// 2M 16-way L2 unified cache shared by 2 threads.
+		{0x00004143, 0x03c0003f, 0x7ff, Cache{2, CacheUnified, 2 << 20, 64, 16, 2}, true},
+		// Fully associative cache of 16 lines.
+		{0x00000243, 0x0000003f, 0xf, Cache{2, CacheUnified, 1 << 10, 64, 16, 1}, true},
+		// End of the list.
+		{0, 0, 0, Cache{}, false},
+	} {
+		got, ok := decodeCacheLeaf(tt.eax, tt.ebx, tt.ecx)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("decodeCacheLeaf(%#x, %#x, %#x) = %+v, %v; want %+v, %v",
+				tt.eax, tt.ebx, tt.ecx, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestParseCacheSize(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		want int
+		ok   bool
+	}{
+		{"32K\n", 32 << 10, true},
+		{"30M", 30 << 20, true},
+		{"512", 512, true},
+		{"", 0, false},
+		{"K", 0, false},
+		{"-1K", 0, false},
+	} {
+		got, ok := parseCacheSize(tt.s)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("parseCacheSize(%q) = %d, %v; want %d, %v", tt.s, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestCountCPUList(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		want int
+		ok   bool
+	}{
+		{"0\n", 1, true},
+		{"0-3,8-11", 8, true},
+		{"0,2,4-5", 4, true},
+		{"", 0, false},
+		{"3-1", 0, false},
+		{"0-x", 0, false},
+	} {
+		got, ok := countCPUList(tt.s)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("countCPUList(%q) = %d, %v; want %d, %v", tt.s, got, ok, tt.want, tt.ok)
+		}
+	}
+}
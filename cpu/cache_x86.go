@@ -0,0 +1,70 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build 386 || amd64 || amd64p32
+
+package cpu
+
+import "sync"
+
+const (
+	// edx bits for CPUID 0x07
+	cpuid_HYBRID = 1 << 15
+
+	// ecx bits for CPUID 0x80000001
+	cpuid_TOPOEXT = 1 << 22
+)
+
+func readCaches() []Cache {
+	maxID, _, _, _ := cpuid(0, 0)
+	leaf := uint32(4)
+	if ID.Vendor == "AuthenticAMD" || ID.Vendor == "HygonGenuine" {
+		// AMD reports leaf 4 as reserved and uses the same format in
+		// leaf 0x8000001D, given topology extensions.
+		maxExtendedID, _, _, _ := cpuid(0x80000000, 0)
+		if maxExtendedID < 0x8000001d {
+			return nil
+		}
+		if _, _, ecx, _ := cpuid(0x80000001, 0); !isSet(ecx, cpuid_TOPOEXT) {
+			return nil
+		}
+		leaf = 0x8000001d
+	} else if maxID < 4 {
+		return nil
+	}
+
+	var caches []Cache
+	for i := uint32(0); i < 32; i++ {
+		eax, ebx, ecx, _ := cpuid(leaf, i)
+		c, ok := decodeCacheLeaf(eax, ebx, ecx)
+		if !ok {
+			break
+		}
+		caches = append(caches, c)
+	}
+	return caches
+}
+
+var x86Hybrid = sync.OnceValue(func() bool {
+	maxID, _, _, _ := cpuid(0, 0)
+	if maxID < 0x1a {
+		return false
+	}
+	_, _, _, edx7 := cpuid(7, 0)
+	return isSet(edx7, cpuid_HYBRID)
+})
+
+func currentCoreType() CoreType {
+	if !x86Hybrid() {
+		return CoreTypeUnknown
+	}
+	eax, _, _, _ := cpuid(0x1a, 0)
+	switch eax >> 24 {
+	case 0x20:
+		return CoreTypeEfficiency
+	case 0x40:
+		return CoreTypePerformance
+	}
+	return CoreTypeUnknown
+}
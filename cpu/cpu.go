@@ -7,7 +7,9 @@
 package cpu
 
 import (
+	"errors"
 	"os"
+	"strconv"
 	"strings"
 )
 
@@ -64,6 +66,7 @@ var X86 struct {
 	HasFMA              bool // Fused-multiply-add instructions
 	HasOSXSAVE          bool // OS supports XSAVE/XRESTOR for saving/restoring XMM registers.
 	HasPCLMULQDQ        bool // PCLMULQDQ instruction - most often used for AES-GCM
+	HasPKU              bool // Protection keys for user-mode pages, enabled by the OS
 	HasPOPCNT           bool // Hamming weight instruction POPCNT.
 	HasRDRAND           bool // RDRAND instruction (on-chip random number generator)
 	HasRDSEED           bool // RDSEED instruction (on-chip random number generator)
@@ -75,6 +78,21 @@ var X86 struct {
 	HasAVXIFMA          bool // Advanced vector extension Integer Fused Multiply Add
 	HasAVXVNNI          bool // Advanced vector extension Vector Neural Network Instructions
 	HasAVXVNNIInt8      bool // Advanced vector extension Vector Neural Network Int8 instructions
+	HasAVX10            bool // Advanced vector extension 10, the converged AVX-512 instruction set
+	HasAVX10_256        bool // AVX10 with 256-bit vector registers
+	HasAVX10_512        bool // AVX10 with 512-bit vector registers
+	AVX10Version        int  // AVX10 version, 0 if AVX10 is not supported
+	HasAPX              bool // Advanced Performance Extensions foundation (APX_F)
+	HasCETIBT           bool // Control-flow Enforcement Technology indirect branch tracking
+	HasCETSS            bool // Control-flow Enforcement Technology shadow stack
+	HasF16C             bool // Half precision floating-point conversion instructions
+	HasGFNI             bool // Galois field New Instructions
+	HasLZCNT            bool // Leading zero count instruction
+	HasMOVBE            bool // Move data after swapping bytes instruction
+	HasSHA              bool // SHA-1 and SHA-256 instructions (SHA NI)
+	HasVAES             bool // Vector AES instructions (VEX encoded)
+	HasVPCLMULQDQ       bool // Vector carry-less multiply operations (VEX encoded)
+	Level               int  // x86-64 microarchitecture level (1 to 4, as in GOAMD64=v1 to v4), 0 if GOARCH is not amd64
 	_                   CacheLinePad
 }
 
@@ -82,35 +100,51 @@ var X86 struct {
 // current ARMv8(aarch64) platform. If the current platform
 // is not arm64 then all feature flags are false.
 var ARM64 struct {
-	_           CacheLinePad
-	HasFP       bool // Floating-point instruction set (always available)
-	HasASIMD    bool // Advanced SIMD (always available)
-	HasEVTSTRM  bool // Event stream support
-	HasAES      bool // AES hardware implementation
-	HasPMULL    bool // Polynomial multiplication instruction set
-	HasSHA1     bool // SHA1 hardware implementation
-	HasSHA2     bool // SHA2 hardware implementation
-	HasCRC32    bool // CRC32 hardware implementation
-	HasATOMICS  bool // Atomic memory operation instruction set
-	HasFPHP     bool // Half precision floating-point instruction set
-	HasASIMDHP  bool // Advanced SIMD half precision instruction set
-	HasCPUID    bool // CPUID identification scheme registers
-	HasASIMDRDM bool // Rounding double multiply add/subtract instruction set
-	HasJSCVT    bool // Javascript conversion from floating-point to integer
-	HasFCMA     bool // Floating-point multiplication and addition of complex numbers
-	HasLRCPC    bool // Release Consistent processor consistent support
-	HasDCPOP    bool // Persistent memory support
-	HasSHA3     bool // SHA3 hardware implementation
-	HasSM3      bool // SM3 hardware implementation
-	HasSM4      bool // SM4 hardware implementation
-	HasASIMDDP  bool // Advanced SIMD double precision instruction set
-	HasSHA512   bool // SHA512 hardware implementation
-	HasSVE      bool // Scalable Vector Extensions
-	HasSVE2     bool // Scalable Vector Extensions 2
-	HasASIMDFHM bool // Advanced SIMD multiplication FP16 to FP32
-	HasDIT      bool // Data Independent Timing support
-	HasI8MM     bool // Advanced SIMD Int8 matrix multiplication instructions
-	_           CacheLinePad
+	_              CacheLinePad
+	HasFP          bool // Floating-point instruction set (always available)
+	HasASIMD       bool // Advanced SIMD (always available)
+	HasEVTSTRM     bool // Event stream support
+	HasAES         bool // AES hardware implementation
+	HasPMULL       bool // Polynomial multiplication instruction set
+	HasSHA1        bool // SHA1 hardware implementation
+	HasSHA2        bool // SHA2 hardware implementation
+	HasCRC32       bool // CRC32 hardware implementation
+	HasATOMICS     bool // Atomic memory operation instruction set
+	HasFPHP        bool // Half precision floating-point instruction set
+	HasASIMDHP     bool // Advanced SIMD half precision instruction set
+	HasCPUID       bool // CPUID identification scheme registers
+	HasASIMDRDM    bool // Rounding double multiply add/subtract instruction set
+	HasJSCVT       bool // Javascript conversion from floating-point to integer
+	HasFCMA        bool // Floating-point multiplication and addition of complex numbers
+	HasLRCPC       bool // Release Consistent processor consistent support
+	HasDCPOP       bool // Persistent memory support
+	HasSHA3        bool // SHA3 hardware implementation
+	HasSM3         bool // SM3 hardware implementation
+	HasSM4         bool // SM4 hardware implementation
+	HasASIMDDP     bool // Advanced SIMD double precision instruction set
+	HasSHA512      bool // SHA512 hardware implementation
+	HasSVE         bool // Scalable Vector Extensions
+	HasSVE2        bool // Scalable Vector Extensions 2
+	HasASIMDFHM    bool // Advanced SIMD multiplication FP16 to FP32
+	HasDIT         bool // Data Independent Timing support
+	HasI8MM        bool // Advanced SIMD Int8 matrix multiplication instructions
+	HasSVE2AES     bool // SVE2 AES instructions
+	HasSVE2BITPERM bool // SVE2 bit permutation instructions
+	HasSVE2SHA3    bool // SVE2 SHA3 instructions
+	HasSVE2SM4     bool // SVE2 SM4 instructions
+	HasBF16        bool // BFloat16 instructions
+	HasSME         bool // Scalable Matrix Extension
+	HasSME2        bool // Scalable Matrix Extension 2
+	HasMTE         bool // Memory Tagging Extension with tag checking
+	HasBTI         bool // Branch Target Identification
+	HasPACA        bool // Address authentication instructions
+	HasPACG        bool // Generic authentication instructions
+	HasRNG         bool // Random number instructions
+	HasLSE2        bool // Unaligned single-copy atomicity within 16 bytes
+	HasLSE128      bool // 128-bit atomic instructions
+	HasFLAGM       bool // Condition flag manipulation instructions
+	SVEVL          uint // SVE vector length in bytes, 0 if undetected
+	_              CacheLinePad
 }
 
 // ARM contains the supported CPU features of the current ARM (32-bit) platform.
@@ -248,7 +282,24 @@ var RISCV64 struct {
 	HasZvks           bool // ShangMi Algorithm Suite
 	HasZvksc          bool // ShangMi Algorithm Suite with carryless multiplication
 	HasZvksg          bool // ShangMi Algorithm Suite with GCM
+	HasZicond         bool // Integer conditional operations
+	HasZicboz         bool // Cache-block zero instructions
+	HasZihintpause    bool // Pause hint
+	HasZfa            bool // Additional floating-point instructions
+	HasZfh            bool // Half-precision floating-point
+	HasZfhmin         bool // Minimal half-precision floating-point
+	HasZacas          bool // Atomic compare-and-swap
+	HasZawrs          bool // Wait-on-reservation-set instructions
+	HasZtso           bool // Total store ordering
+	HasZvfh           bool // Vector half-precision floating-point
+	HasZve32x         bool // Vector extension for embedded processors, 32-bit integer elements
+	HasZve32f         bool // Zve32x with single-precision floating-point elements
+	HasZve64x         bool // Vector extension for embedded processors, 64-bit integer elements
+	HasZve64f         bool // Zve64x with single-precision floating-point elements
+	HasZve64d         bool // Zve64f with double-precision floating-point elements
+	HasXTheadVector   bool // T-Head vector extension, based on the incompatible RVV 0.7.1
 	VLENB             uint // Vector register length in bytes, 0 if undetected
+	ZicbozBlockSize   uint // Block size in bytes of the cbo.zero instruction, 0 if undetected
 	_                 CacheLinePad
 }
 
@@ -259,7 +310,7 @@ var doDerived func()
 func init() {
 	archInit()
 	initOptions()
-	processOptions()
+	processOptions(os.Getenv("GODEBUG"))
 	if doDerived != nil {
 		doDerived()
 	}
@@ -275,13 +326,35 @@ var options []option
 type option struct {
 	Name      string
 	Feature   *bool
+	Detected  bool // whether feature was detected, before applying GODEBUG
 	Specified bool // whether feature value was specified in GODEBUG
 	Enable    bool // whether feature should be enabled
 	Required  bool // whether feature is mandatory and can not be disabled
 }
 
-func processOptions() {
-	env := os.Getenv("GODEBUG")
+// godebugErrors holds the problems found in the cpu options of GODEBUG.
+var godebugErrors []error
+
+func godebugError(msg ...string) {
+	godebugErrors = append(godebugErrors, errors.New("GODEBUG sys/cpu: "+strings.Join(msg, "")))
+}
+
+// godebugMasked reports whether the feature f was detected but disabled by
+// GODEBUG.
+func godebugMasked(f *bool) bool {
+	for _, o := range options {
+		if o.Feature == f {
+			return o.Detected && !*f
+		}
+	}
+	return false
+}
+
+func processOptions(env string) {
+	for i := range options {
+		options[i].Detected = *options[i].Feature
+	}
+
 field:
 	for env != "" {
 		field := ""
@@ -296,7 +369,7 @@ field:
 		}
 		i = strings.IndexByte(field, '=')
 		if i < 0 {
-			print("GODEBUG sys/cpu: no value specified for \"", field, "\"\n")
+			godebugError("no value specified for ", strconv.Quote(field))
 			continue
 		}
 		key, value := field[4:i], field[i+1:] // e.g. "SSE2", "on"
@@ -308,7 +381,7 @@ field:
 		case "off":
 			enable = false
 		default:
-			print("GODEBUG sys/cpu: value \"", value, "\" not supported for cpu option \"", key, "\"\n")
+			godebugError("value ", strconv.Quote(value), " not supported for cpu option ", strconv.Quote(key))
 			continue field
 		}
 
@@ -328,7 +401,7 @@ field:
 			}
 		}
 
-		print("GODEBUG sys/cpu: unknown cpu feature \"", key, "\"\n")
+		godebugError("unknown cpu feature ", strconv.Quote(key))
 	}
 
 	for _, o := range options {
@@ -337,12 +410,12 @@ field:
 		}
 
 		if o.Enable && !*o.Feature {
-			print("GODEBUG sys/cpu: can not enable \"", o.Name, "\", missing CPU support\n")
+			godebugError("can not enable ", strconv.Quote(o.Name), ", missing CPU support")
 			continue
 		}
 
 		if !o.Enable && o.Required {
-			print("GODEBUG sys/cpu: can not disable \"", o.Name, "\", required CPU feature\n")
+			godebugError("can not disable ", strconv.Quote(o.Name), ", required CPU feature")
 			continue
 		}
 
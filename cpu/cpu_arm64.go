@@ -40,10 +40,42 @@ func initOptions() {
 		{Name: "asimdfhm", Feature: &ARM64.HasASIMDFHM},
 		{Name: "dit", Feature: &ARM64.HasDIT},
 		{Name: "i8mm", Feature: &ARM64.HasI8MM},
+		{Name: "sve2aes", Feature: &ARM64.HasSVE2AES},
+		{Name: "sve2bitperm", Feature: &ARM64.HasSVE2BITPERM},
+		{Name: "sve2sha3", Feature: &ARM64.HasSVE2SHA3},
+		{Name: "sve2sm4", Feature: &ARM64.HasSVE2SM4},
+		{Name: "bf16", Feature: &ARM64.HasBF16},
+		{Name: "sme", Feature: &ARM64.HasSME},
+		{Name: "sme2", Feature: &ARM64.HasSME2},
+		{Name: "mte", Feature: &ARM64.HasMTE},
+		{Name: "bti", Feature: &ARM64.HasBTI},
+		{Name: "paca", Feature: &ARM64.HasPACA},
+		{Name: "pacg", Feature: &ARM64.HasPACG},
+		{Name: "rng", Feature: &ARM64.HasRNG},
+		{Name: "lse2", Feature: &ARM64.HasLSE2},
+		{Name: "lse128", Feature: &ARM64.HasLSE128},
+		{Name: "flagm", Feature: &ARM64.HasFLAGM},
 	}
 }
 
 func archInit() {
+	doDerived = func() {
+		// Extensions of SVE, SVE2 and SME are disabled along with them.
+		if !ARM64.HasSVE {
+			ARM64.HasSVE2 = false
+			ARM64.SVEVL = 0
+		}
+		if !ARM64.HasSVE2 {
+			ARM64.HasSVE2AES = false
+			ARM64.HasSVE2BITPERM = false
+			ARM64.HasSVE2SHA3 = false
+			ARM64.HasSVE2SM4 = false
+		}
+		if !ARM64.HasSME {
+			ARM64.HasSME2 = false
+		}
+	}
+
 	if runtime.GOOS == "freebsd" {
 		readARM64Registers()
 	} else {
@@ -62,10 +94,10 @@ func setMinimalFeatures() {
 func readARM64Registers() {
 	Initialized = true
 
-	parseARM64SystemRegisters(getisar0(), getisar1(), getpfr0())
+	parseARM64SystemRegisters(getisar0(), getisar1(), getisar2(), getpfr0(), getpfr1(), getmmfr2())
 }
 
-func parseARM64SystemRegisters(isar0, isar1, pfr0 uint64) {
+func parseARM64SystemRegisters(isar0, isar1, isar2, pfr0, pfr1, mmfr2 uint64) {
 	// ID_AA64ISAR0_EL1
 	switch extractBits(isar0, 4, 7) {
 	case 1:
@@ -96,6 +128,9 @@ func parseARM64SystemRegisters(isar0, isar1, pfr0 uint64) {
 	switch extractBits(isar0, 20, 23) {
 	case 2:
 		ARM64.HasATOMICS = true
+	case 3:
+		ARM64.HasATOMICS = true
+		ARM64.HasLSE128 = true
 	}
 
 	switch extractBits(isar0, 28, 31) {
@@ -123,12 +158,26 @@ func parseARM64SystemRegisters(isar0, isar1, pfr0 uint64) {
 		ARM64.HasASIMDDP = true
 	}
 
+	switch extractBits(isar0, 52, 55) {
+	case 1, 2:
+		ARM64.HasFLAGM = true
+	}
+
+	switch extractBits(isar0, 60, 63) {
+	case 1:
+		ARM64.HasRNG = true
+	}
+
 	// ID_AA64ISAR1_EL1
 	switch extractBits(isar1, 0, 3) {
 	case 1:
 		ARM64.HasDCPOP = true
 	}
 
+	if extractBits(isar1, 4, 7) != 0 || extractBits(isar1, 8, 11) != 0 {
+		ARM64.HasPACA = true
+	}
+
 	switch extractBits(isar1, 12, 15) {
 	case 1:
 		ARM64.HasJSCVT = true
@@ -144,11 +193,29 @@ func parseARM64SystemRegisters(isar0, isar1, pfr0 uint64) {
 		ARM64.HasLRCPC = true
 	}
 
+	if extractBits(isar1, 24, 27) != 0 || extractBits(isar1, 28, 31) != 0 {
+		ARM64.HasPACG = true
+	}
+
+	switch extractBits(isar1, 44, 47) {
+	case 1, 2:
+		ARM64.HasBF16 = true
+	}
+
 	switch extractBits(isar1, 52, 55) {
 	case 1:
 		ARM64.HasI8MM = true
 	}
 
+	// ID_AA64ISAR2_EL1
+	if extractBits(isar2, 12, 15) != 0 {
+		ARM64.HasPACA = true // FEAT_PACQARMA3
+	}
+
+	if extractBits(isar2, 8, 11) != 0 {
+		ARM64.HasPACG = true // FEAT_PACQARMA3
+	}
+
 	// ID_AA64PFR0_EL1
 	switch extractBits(pfr0, 16, 19) {
 	case 0:
@@ -177,12 +244,59 @@ func parseARM64SystemRegisters(isar0, isar1, pfr0 uint64) {
 	case 1:
 		ARM64.HasDIT = true
 	}
+
+	// ID_AA64PFR1_EL1
+	switch extractBits(pfr1, 0, 3) {
+	case 1:
+		ARM64.HasBTI = true
+	}
+
+	switch extractBits(pfr1, 8, 11) {
+	case 2, 3:
+		ARM64.HasMTE = true
+	}
+
+	switch extractBits(pfr1, 24, 27) {
+	case 1:
+		ARM64.HasSME = true
+	case 2:
+		ARM64.HasSME = true
+		ARM64.HasSME2 = true
+	}
+
+	// ID_AA64MMFR2_EL1
+	switch extractBits(mmfr2, 32, 35) {
+	case 1:
+		ARM64.HasLSE2 = true
+	}
 }
 
 func parseARM64SVERegister(zfr0 uint64) {
 	switch extractBits(zfr0, 0, 3) {
-	case 1:
+	case 1, 2:
 		ARM64.HasSVE2 = true
+	default:
+		return
+	}
+
+	switch extractBits(zfr0, 4, 7) {
+	case 1, 2:
+		ARM64.HasSVE2AES = true
+	}
+
+	switch extractBits(zfr0, 16, 19) {
+	case 1:
+		ARM64.HasSVE2BITPERM = true
+	}
+
+	switch extractBits(zfr0, 32, 35) {
+	case 1:
+		ARM64.HasSVE2SHA3 = true
+	}
+
+	switch extractBits(zfr0, 40, 43) {
+	case 1:
+		ARM64.HasSVE2SM4 = true
 	}
 }
 
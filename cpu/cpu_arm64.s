@@ -20,6 +20,14 @@ TEXT ·getisar1(SB),NOSPLIT,$0-8
 	MOVD	R0, ret+0(FP)
 	RET
 
+// func getisar2() uint64
+TEXT ·getisar2(SB),NOSPLIT,$0-8
+	// get Instruction Set Attributes 2 into x0
+	// MRS ID_AA64ISAR2_EL1, R0, which the assembler does not know
+	WORD	$0xd5380640
+	MOVD	R0, ret+0(FP)
+	RET
+
 // func getpfr0() uint64
 TEXT ·getpfr0(SB),NOSPLIT,$0-8
 	// get Processor Feature Register 0 into x0
@@ -27,6 +35,34 @@ TEXT ·getpfr0(SB),NOSPLIT,$0-8
 	MOVD	R0, ret+0(FP)
 	RET
 
+// func getmidr() uint64
+TEXT ·getmidr(SB),NOSPLIT,$0-8
+	// get Main ID Register into x0
+	MRS	MIDR_EL1, R0
+	MOVD	R0, ret+0(FP)
+	RET
+
+// func getctr() uint64
+TEXT ·getctr(SB),NOSPLIT,$0-8
+	// get Cache Type Register into x0
+	MRS	CTR_EL0, R0
+	MOVD	R0, ret+0(FP)
+	RET
+
+// func getpfr1() uint64
+TEXT ·getpfr1(SB),NOSPLIT,$0-8
+	// get Processor Feature Register 1 into x0
+	MRS	ID_AA64PFR1_EL1, R0
+	MOVD	R0, ret+0(FP)
+	RET
+
+// func getmmfr2() uint64
+TEXT ·getmmfr2(SB),NOSPLIT,$0-8
+	// get Memory Model Feature Register 2 into x0
+	MRS	ID_AA64MMFR2_EL1, R0
+	MOVD	R0, ret+0(FP)
+	RET
+
 // func getzfr0() uint64
 TEXT ·getzfr0(SB),NOSPLIT,$0-8
 	// get SVE Feature Register 0 into x0
@@ -0,0 +1,89 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cpu
+
+import "testing"
+
+func TestParseARM64SystemRegisters(t *testing.T) {
+	saved := ARM64
+	defer func() { ARM64 = saved }()
+	ARM64.HasATOMICS, ARM64.HasLSE128, ARM64.HasFLAGM, ARM64.HasRNG = false, false, false, false
+	ARM64.HasPACA, ARM64.HasPACG, ARM64.HasBF16 = false, false, false
+	ARM64.HasBTI, ARM64.HasMTE, ARM64.HasSME, ARM64.HasSME2, ARM64.HasLSE2 = false, false, false, false, false
+
+	const (
+		isar0 = 3<<20 | 1<<52 | 1<<60 // LSE128, FlagM, RNDR
+		isar1 = 1<<4 | 1<<24 | 1<<44  // PAuth (QARMA5), BF16
+		pfr0  = 0                     // FP and AdvSIMD, no SVE
+		pfr1  = 1 | 2<<8 | 2<<24      // BTI, MTE2, SME2
+		mmfr2 = 1 << 32               // LSE2
+	)
+	parseARM64SystemRegisters(isar0, isar1, 0, pfr0, pfr1, mmfr2)
+
+	for _, f := range []struct {
+		name string
+		has  bool
+	}{
+		{"ATOMICS", ARM64.HasATOMICS},
+		{"LSE128", ARM64.HasLSE128},
+		{"FLAGM", ARM64.HasFLAGM},
+		{"RNG", ARM64.HasRNG},
+		{"PACA", ARM64.HasPACA},
+		{"PACG", ARM64.HasPACG},
+		{"BF16", ARM64.HasBF16},
+		{"BTI", ARM64.HasBTI},
+		{"MTE", ARM64.HasMTE},
+		{"SME", ARM64.HasSME},
+		{"SME2", ARM64.HasSME2},
+		{"LSE2", ARM64.HasLSE2},
+	} {
+		if !f.has {
+			t.Errorf("Has%s expected true, got false", f.name)
+		}
+	}
+}
+
+func TestParseARM64PAuthQARMA3(t *testing.T) {
+	saved := ARM64
+	defer func() { ARM64 = saved }()
+
+	ARM64.HasPACA, ARM64.HasPACG = false, false
+	parseARM64SystemRegisters(0, 0, 0, 0, 0, 0)
+	if ARM64.HasPACA || ARM64.HasPACG {
+		t.Errorf("HasPACA, HasPACG = %v, %v without PAuth, want false, false", ARM64.HasPACA, ARM64.HasPACG)
+	}
+
+	const isar2 = 1<<12 | 1<<8 // APA3, GPA3
+	parseARM64SystemRegisters(0, 0, isar2, 0, 0, 0)
+	if !ARM64.HasPACA || !ARM64.HasPACG {
+		t.Errorf("HasPACA, HasPACG = %v, %v with QARMA3, want true, true", ARM64.HasPACA, ARM64.HasPACG)
+	}
+}
+
+func TestParseARM64SVERegister(t *testing.T) {
+	saved := ARM64
+	defer func() { ARM64 = saved }()
+
+	type sve2 struct{ SVE2, AES, BitPerm, SHA3, SM4 bool }
+	for _, tt := range []struct {
+		zfr0 uint64
+		want sve2
+	}{
+		{0, sve2{}},
+		// Crypto fields are ignored without SVE2.
+		{1<<4 | 1<<16 | 1<<32 | 1<<40, sve2{}},
+		{1, sve2{SVE2: true}},
+		{1 | 2<<4 | 1<<16 | 1<<32 | 1<<40, sve2{true, true, true, true, true}},
+		{2 | 1<<4, sve2{SVE2: true, AES: true}},
+		{1 | 1<<32, sve2{SVE2: true, SHA3: true}},
+	} {
+		ARM64.HasSVE2, ARM64.HasSVE2AES, ARM64.HasSVE2BITPERM, ARM64.HasSVE2SHA3, ARM64.HasSVE2SM4 = false, false, false, false, false
+		parseARM64SVERegister(tt.zfr0)
+		got := sve2{ARM64.HasSVE2, ARM64.HasSVE2AES, ARM64.HasSVE2BITPERM, ARM64.HasSVE2SHA3, ARM64.HasSVE2SM4}
+		if got != tt.want {
+			t.Errorf("parseARM64SVERegister(%#x) = %+v, want %+v", tt.zfr0, got, tt.want)
+		}
+	}
+}
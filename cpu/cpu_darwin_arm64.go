@@ -40,6 +40,7 @@ func doinit() {
 	// Atomic and memory ordering
 	ARM64.HasATOMICS = darwinSysctlEnabled([]byte("hw.optional.armv8_1_atomics\x00")) || darwinSysctlEnabled([]byte("hw.optional.arm.FEAT_LSE\x00"))
 	ARM64.HasLRCPC = darwinSysctlEnabled([]byte("hw.optional.arm.FEAT_LRCPC\x00"))
+	ARM64.HasLSE2 = darwinSysctlEnabled([]byte("hw.optional.arm.FEAT_LSE2\x00"))
 
 	// SIMD and floating point capabilities
 	ARM64.HasFPHP = darwinSysctlEnabled([]byte("hw.optional.neon_fp16\x00")) || darwinSysctlEnabled([]byte("hw.optional.arm.FEAT_FP16\x00"))
@@ -48,6 +49,9 @@ func doinit() {
 	ARM64.HasASIMDDP = darwinSysctlEnabled([]byte("hw.optional.arm.FEAT_DotProd\x00"))
 	ARM64.HasASIMDFHM = darwinSysctlEnabled([]byte("hw.optional.armv8_2_fhm\x00")) || darwinSysctlEnabled([]byte("hw.optional.arm.FEAT_FHM\x00"))
 	ARM64.HasI8MM = darwinSysctlEnabled([]byte("hw.optional.arm.FEAT_I8MM\x00"))
+	ARM64.HasBF16 = darwinSysctlEnabled([]byte("hw.optional.arm.FEAT_BF16\x00"))
+	ARM64.HasSME = darwinSysctlEnabled([]byte("hw.optional.arm.FEAT_SME\x00"))
+	ARM64.HasSME2 = darwinSysctlEnabled([]byte("hw.optional.arm.FEAT_SME2\x00"))
 
 	ARM64.HasJSCVT = darwinSysctlEnabled([]byte("hw.optional.arm.FEAT_JSCVT\x00"))
 	ARM64.HasFCMA = darwinSysctlEnabled([]byte("hw.optional.armv8_3_compnum\x00")) || darwinSysctlEnabled([]byte("hw.optional.arm.FEAT_FCMA\x00"))
@@ -56,6 +60,8 @@ func doinit() {
 	ARM64.HasDCPOP = darwinSysctlEnabled([]byte("hw.optional.arm.FEAT_DPB\x00"))
 	ARM64.HasEVTSTRM = darwinSysctlEnabled([]byte("hw.optional.arm.FEAT_ECV\x00"))
 	ARM64.HasDIT = darwinSysctlEnabled([]byte("hw.optional.arm.FEAT_DIT\x00"))
+	ARM64.HasFLAGM = darwinSysctlEnabled([]byte("hw.optional.arm.FEAT_FlagM\x00"))
+	ARM64.HasBTI = darwinSysctlEnabled([]byte("hw.optional.arm.FEAT_BTI\x00"))
 
 	// Not supported, but added for completeness
 	ARM64.HasCPUID = false
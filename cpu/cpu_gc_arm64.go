@@ -8,5 +8,10 @@ package cpu
 
 func getisar0() uint64
 func getisar1() uint64
+func getisar2() uint64
 func getpfr0() uint64
+func getpfr1() uint64
+func getmmfr2() uint64
 func getzfr0() uint64
+func getmidr() uint64
+func getctr() uint64
@@ -8,5 +8,10 @@ package cpu
 
 func getisar0() uint64 { return 0 }
 func getisar1() uint64 { return 0 }
+func getisar2() uint64 { return 0 }
 func getpfr0() uint64  { return 0 }
+func getpfr1() uint64  { return 0 }
+func getmmfr2() uint64 { return 0 }
 func getzfr0() uint64  { return 0 }
+func getmidr() uint64  { return 0 }
+func getctr() uint64   { return 0 }
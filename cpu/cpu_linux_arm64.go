@@ -36,9 +36,30 @@ const (
 	hwcap_SVE      = 1 << 22
 	hwcap_ASIMDFHM = 1 << 23
 	hwcap_DIT      = 1 << 24
+	hwcap_USCAT    = 1 << 25
+	hwcap_FLAGM    = 1 << 27
+	hwcap_PACA     = 1 << 30
+	hwcap_PACG     = 1 << 31
 
-	hwcap2_SVE2 = 1 << 1
-	hwcap2_I8MM = 1 << 13
+	hwcap2_SVE2       = 1 << 1
+	hwcap2_SVEAES     = 1 << 2
+	hwcap2_SVEBITPERM = 1 << 4
+	hwcap2_SVESHA3    = 1 << 5
+	hwcap2_SVESM4     = 1 << 6
+	hwcap2_I8MM       = 1 << 13
+	hwcap2_BF16       = 1 << 14
+	hwcap2_RNG        = 1 << 16
+	hwcap2_BTI        = 1 << 17
+	hwcap2_MTE        = 1 << 18
+	hwcap2_SME        = 1 << 23
+	hwcap2_SME2       = 1 << 37
+	hwcap2_LSE128     = 1 << 47
+)
+
+// prctl(2) option to read the SVE vector length of the calling thread.
+const (
+	_PR_SVE_GET_VL      = 51
+	_PR_SVE_VL_LEN_MASK = 0xffff
 )
 
 // linuxKernelCanEmulateCPUID reports whether we're running
@@ -61,6 +82,9 @@ func linuxKernelCanEmulateCPUID() bool {
 }
 
 func doinit() {
+	defer readMIDR()
+	defer readSVEVL()
+
 	if err := readHWCAP(); err != nil {
 		// We failed to read /proc/self/auxv. This can happen if the binary has
 		// been given extra capabilities(7) with /bin/setcap.
@@ -109,10 +133,47 @@ func doinit() {
 	ARM64.HasSVE = isSet(hwCap, hwcap_SVE)
 	ARM64.HasASIMDFHM = isSet(hwCap, hwcap_ASIMDFHM)
 	ARM64.HasDIT = isSet(hwCap, hwcap_DIT)
+	ARM64.HasLSE2 = isSet(hwCap, hwcap_USCAT)
+	ARM64.HasFLAGM = isSet(hwCap, hwcap_FLAGM)
+	ARM64.HasPACA = isSet(hwCap, hwcap_PACA)
+	ARM64.HasPACG = isSet(hwCap, hwcap_PACG)
 
 	// HWCAP2 feature bits
 	ARM64.HasSVE2 = isSet(hwCap2, hwcap2_SVE2)
+	ARM64.HasSVE2AES = isSet(hwCap2, hwcap2_SVEAES)
+	ARM64.HasSVE2BITPERM = isSet(hwCap2, hwcap2_SVEBITPERM)
+	ARM64.HasSVE2SHA3 = isSet(hwCap2, hwcap2_SVESHA3)
+	ARM64.HasSVE2SM4 = isSet(hwCap2, hwcap2_SVESM4)
 	ARM64.HasI8MM = isSet(hwCap2, hwcap2_I8MM)
+	ARM64.HasBF16 = isSet(hwCap2, hwcap2_BF16)
+	ARM64.HasRNG = isSet(hwCap2, hwcap2_RNG)
+	ARM64.HasBTI = isSet(hwCap2, hwcap2_BTI)
+	ARM64.HasMTE = isSet(hwCap2, hwcap2_MTE)
+	ARM64.HasSME = isSet(hwCap2, hwcap2_SME)
+	ARM64.HasSME2 = isSet(hwCap2, hwcap2_SME2)
+	ARM64.HasLSE128 = isSet(hwCap2, hwcap2_LSE128)
+}
+
+// readSVEVL sets the SVE vector length from that of the calling thread,
+// which is inherited from the process unless changed with prctl(2).
+func readSVEVL() {
+	if !ARM64.HasSVE {
+		return
+	}
+	vl, _, errno := syscall.RawSyscall(syscall.SYS_PRCTL, _PR_SVE_GET_VL, 0, 0)
+	if errno != 0 {
+		return
+	}
+	ARM64.SVEVL = uint(vl & _PR_SVE_VL_LEN_MASK)
+}
+
+// readMIDR populates ID from the MIDR_EL1 register of the current CPU if
+// the kernel emulates reading it. It does not fall back to sysfs, so that
+// importing the package does not read files; ReadIdentity does.
+func readMIDR() {
+	if ARM64.HasCPUID {
+		ID.setMIDR(getmidr())
+	}
 }
 
 func isSet(hwc uint, value uint) bool {
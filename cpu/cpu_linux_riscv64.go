@@ -58,6 +58,7 @@ const (
 	riscv_HWPROBE_EXT_ZBA         = 0x8
 	riscv_HWPROBE_EXT_ZBB         = 0x10
 	riscv_HWPROBE_EXT_ZBS         = 0x20
+	riscv_HWPROBE_EXT_ZICBOZ      = 0x40
 	riscv_HWPROBE_EXT_ZBC         = 0x80
 	riscv_HWPROBE_EXT_ZVBB        = 0x20000
 	riscv_HWPROBE_EXT_ZVBC        = 0x40000
@@ -68,9 +69,29 @@ const (
 	riscv_HWPROBE_EXT_ZVKSED      = 0x1000000
 	riscv_HWPROBE_EXT_ZVKSH       = 0x2000000
 	riscv_HWPROBE_EXT_ZVKT        = 0x4000000
+	riscv_HWPROBE_EXT_ZFH         = 0x8000000
+	riscv_HWPROBE_EXT_ZFHMIN      = 0x10000000
+	riscv_HWPROBE_EXT_ZVFH        = 0x40000000
+	riscv_HWPROBE_EXT_ZFA         = 0x100000000
+	riscv_HWPROBE_EXT_ZTSO        = 0x200000000
+	riscv_HWPROBE_EXT_ZACAS       = 0x400000000
+	riscv_HWPROBE_EXT_ZICOND      = 0x800000000
+	riscv_HWPROBE_EXT_ZIHINTPAUSE = 0x1000000000
+	riscv_HWPROBE_EXT_ZVE32X      = 0x2000000000
+	riscv_HWPROBE_EXT_ZVE32F      = 0x4000000000
+	riscv_HWPROBE_EXT_ZVE64X      = 0x8000000000
+	riscv_HWPROBE_EXT_ZVE64F      = 0x10000000000
+	riscv_HWPROBE_EXT_ZVE64D      = 0x20000000000
+	riscv_HWPROBE_EXT_ZAWRS       = 0x1000000000000
 	riscv_HWPROBE_KEY_CPUPERF_0   = 0x5
 	riscv_HWPROBE_MISALIGNED_FAST = 0x3
 	riscv_HWPROBE_MISALIGNED_MASK = 0x7
+
+	riscv_HWPROBE_KEY_ZICBOZ_BLOCK_SIZE      = 0x6
+	riscv_HWPROBE_KEY_MISALIGNED_SCALAR_PERF = 0x9
+	riscv_HWPROBE_MISALIGNED_SCALAR_FAST     = 0x3
+	riscv_HWPROBE_KEY_VENDOR_EXT_THEAD_0     = 0xb
+	riscv_HWPROBE_VENDOR_EXT_XTHEADVECTOR    = 0x1
 )
 
 const (
@@ -98,6 +119,9 @@ func doinit() {
 	pairs := []riscvHWProbePairs{
 		{riscv_HWPROBE_KEY_IMA_EXT_0, 0},
 		{riscv_HWPROBE_KEY_CPUPERF_0, 0},
+		{riscv_HWPROBE_KEY_MISALIGNED_SCALAR_PERF, 0},
+		{riscv_HWPROBE_KEY_ZICBOZ_BLOCK_SIZE, 0},
+		{riscv_HWPROBE_KEY_VENDOR_EXT_THEAD_0, 0},
 	}
 
 	// This call only indicates that extensions are supported if they are implemented on all cores.
@@ -115,6 +139,21 @@ func doinit() {
 			RISCV64.HasZvkb = isSet(v, riscv_HWPROBE_EXT_ZVKB)
 			RISCV64.HasZvkg = isSet(v, riscv_HWPROBE_EXT_ZVKG)
 			RISCV64.HasZvkt = isSet(v, riscv_HWPROBE_EXT_ZVKT)
+			RISCV64.HasZicond = isSet(v, riscv_HWPROBE_EXT_ZICOND)
+			RISCV64.HasZicboz = isSet(v, riscv_HWPROBE_EXT_ZICBOZ)
+			RISCV64.HasZihintpause = isSet(v, riscv_HWPROBE_EXT_ZIHINTPAUSE)
+			RISCV64.HasZfa = isSet(v, riscv_HWPROBE_EXT_ZFA)
+			RISCV64.HasZfh = isSet(v, riscv_HWPROBE_EXT_ZFH)
+			RISCV64.HasZfhmin = isSet(v, riscv_HWPROBE_EXT_ZFHMIN)
+			RISCV64.HasZacas = isSet(v, riscv_HWPROBE_EXT_ZACAS)
+			RISCV64.HasZawrs = isSet(v, riscv_HWPROBE_EXT_ZAWRS)
+			RISCV64.HasZtso = isSet(v, riscv_HWPROBE_EXT_ZTSO)
+			RISCV64.HasZvfh = isSet(v, riscv_HWPROBE_EXT_ZVFH)
+			RISCV64.HasZve32x = isSet(v, riscv_HWPROBE_EXT_ZVE32X)
+			RISCV64.HasZve32f = isSet(v, riscv_HWPROBE_EXT_ZVE32F)
+			RISCV64.HasZve64x = isSet(v, riscv_HWPROBE_EXT_ZVE64X)
+			RISCV64.HasZve64f = isSet(v, riscv_HWPROBE_EXT_ZVE64F)
+			RISCV64.HasZve64d = isSet(v, riscv_HWPROBE_EXT_ZVE64D)
 			// Cryptography shorthand extensions
 			RISCV64.HasZvkn = isSet(v, riscv_HWPROBE_EXT_ZVKNED) &&
 				isSet(v, riscv_HWPROBE_EXT_ZVKNHB) && RISCV64.HasZvkb && RISCV64.HasZvkt
@@ -125,10 +164,20 @@ func doinit() {
 			RISCV64.HasZvksc = RISCV64.HasZvks && RISCV64.HasZvbc
 			RISCV64.HasZvksg = RISCV64.HasZvks && RISCV64.HasZvkg
 		}
-		if pairs[1].key != -1 {
+		// CPUPERF_0 is deprecated in favor of MISALIGNED_SCALAR_PERF, which
+		// Linux 6.11 and later report.
+		if pairs[2].key != -1 {
+			RISCV64.HasFastMisaligned = pairs[2].value == riscv_HWPROBE_MISALIGNED_SCALAR_FAST
+		} else if pairs[1].key != -1 {
 			v := pairs[1].value & riscv_HWPROBE_MISALIGNED_MASK
 			RISCV64.HasFastMisaligned = v == riscv_HWPROBE_MISALIGNED_FAST
 		}
+		if pairs[3].key != -1 && RISCV64.HasZicboz {
+			RISCV64.ZicbozBlockSize = uint(pairs[3].value)
+		}
+		if pairs[4].key != -1 {
+			RISCV64.HasXTheadVector = isSet(uint(pairs[4].value), riscv_HWPROBE_VENDOR_EXT_XTHEADVECTOR)
+		}
 	}
 	if RISCV64.HasV {
 		RISCV64.VLENB = readVLENB()
@@ -146,6 +195,9 @@ func doinit() {
 		if !RISCV64.HasV {
 			RISCV64.VLENB = 0
 		}
+		if !RISCV64.HasZicboz {
+			RISCV64.ZicbozBlockSize = 0
+		}
 	}
 
 }
@@ -167,7 +167,10 @@ func doinit() {
 		setMinimalFeatures()
 		return
 	}
-	parseARM64SystemRegisters(cpuid.aa64isar0, cpuid.aa64isar1, cpuid.aa64pfr0)
+	// NetBSD does not report ID_AA64ISAR2_EL1, so pointer authentication
+	// with the QARMA3 algorithm is not detected.
+	parseARM64SystemRegisters(cpuid.aa64isar0, cpuid.aa64isar1, 0, cpuid.aa64pfr0, cpuid.aa64pfr1, cpuid.aa64mmfr2)
+	ID.setMIDR(cpuid.midr)
 
 	Initialized = true
 }
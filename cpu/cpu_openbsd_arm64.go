@@ -19,6 +19,7 @@ const (
 	// From OpenBSD's machine/cpu.h.
 	_CPU_ID_AA64ISAR0 = 2
 	_CPU_ID_AA64ISAR1 = 3
+	_CPU_ID_AA64ISAR2 = 4
 )
 
 // Implemented in the runtime package (runtime/sys_openbsd3.go)
@@ -59,7 +60,9 @@ func doinit() {
 	if !ok {
 		return
 	}
-	parseARM64SystemRegisters(isar0, isar1, 0)
+	// Older versions of OpenBSD do not report ID_AA64ISAR2.
+	isar2, _ := sysctlUint64([]uint32{_CTL_MACHDEP, _CPU_ID_AA64ISAR2})
+	parseARM64SystemRegisters(isar0, isar1, isar2, 0, 0, 0)
 
 	Initialized = true
 }
@@ -17,6 +17,24 @@ func initOptions() {
 		{Name: "zbb", Feature: &RISCV64.HasZbb},
 		{Name: "zbs", Feature: &RISCV64.HasZbs},
 		{Name: "zbc", Feature: &RISCV64.HasZbc},
+		{Name: "zicond", Feature: &RISCV64.HasZicond},
+		{Name: "zicboz", Feature: &RISCV64.HasZicboz},
+		{Name: "zihintpause", Feature: &RISCV64.HasZihintpause},
+		{Name: "zfa", Feature: &RISCV64.HasZfa},
+		{Name: "zfh", Feature: &RISCV64.HasZfh},
+		{Name: "zfhmin", Feature: &RISCV64.HasZfhmin},
+		{Name: "zacas", Feature: &RISCV64.HasZacas},
+		{Name: "zawrs", Feature: &RISCV64.HasZawrs},
+		{Name: "ztso", Feature: &RISCV64.HasZtso},
+		// RISC-V Vector Extensions
+		{Name: "zvfh", Feature: &RISCV64.HasZvfh},
+		{Name: "zve32x", Feature: &RISCV64.HasZve32x},
+		{Name: "zve32f", Feature: &RISCV64.HasZve32f},
+		{Name: "zve64x", Feature: &RISCV64.HasZve64x},
+		{Name: "zve64f", Feature: &RISCV64.HasZve64f},
+		{Name: "zve64d", Feature: &RISCV64.HasZve64d},
+		// Vendor extensions
+		{Name: "xtheadvector", Feature: &RISCV64.HasXTheadVector},
 		// RISC-V Cryptography Extensions
 		{Name: "zvbb", Feature: &RISCV64.HasZvbb},
 		{Name: "zvbc", Feature: &RISCV64.HasZvbc},
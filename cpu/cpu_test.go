@@ -75,6 +75,84 @@ func TestAVXVNNIInt8HasAVXVNNIAndAVX(t *testing.T) {
 	}
 }
 
+func TestX86Level(t *testing.T) {
+	if runtime.GOARCH == "amd64" && cpu.X86.Level < 1 {
+		t.Fatalf("Level expected at least 1, got %d", cpu.X86.Level)
+	}
+	if runtime.GOARCH != "amd64" && cpu.X86.Level != 0 {
+		t.Fatalf("Level expected 0 on %s, got %d", runtime.GOARCH, cpu.X86.Level)
+	}
+	if cpu.X86.Level >= 2 && !(cpu.X86.HasPOPCNT && cpu.X86.HasSSE42 && cpu.X86.HasCX16) {
+		t.Errorf("Level %d without v2 features", cpu.X86.Level)
+	}
+	if cpu.X86.Level >= 3 && !(cpu.X86.HasAVX2 && cpu.X86.HasBMI2 && cpu.X86.HasLZCNT && cpu.X86.HasMOVBE) {
+		t.Errorf("Level %d without v3 features", cpu.X86.Level)
+	}
+	if cpu.X86.Level >= 4 && !(cpu.X86.HasAVX512F && cpu.X86.HasAVX512VL) {
+		t.Errorf("Level %d without v4 features", cpu.X86.Level)
+	}
+}
+
+func TestAVX10Version(t *testing.T) {
+	if cpu.X86.HasAVX10 && cpu.X86.AVX10Version == 0 {
+		t.Fatal("AVX10Version should be non-zero when HasAVX10 is true")
+	}
+	if !cpu.X86.HasAVX10 && (cpu.X86.AVX10Version != 0 || cpu.X86.HasAVX10_256 || cpu.X86.HasAVX10_512) {
+		t.Fatal("AVX10 details should be unset when HasAVX10 is false")
+	}
+}
+
+func TestIdentity(t *testing.T) {
+	if (runtime.GOARCH == "amd64" || runtime.GOARCH == "386") && cpu.ID.Vendor == "" {
+		t.Fatal("Vendor expected to be set on x86")
+	}
+	t.Logf("%+v", cpu.ID)
+
+	id := cpu.ReadIdentity()
+	if cpu.ID.Vendor != "" && id != cpu.ID {
+		t.Errorf("ReadIdentity: got %+v, want ID", id)
+	}
+	t.Logf("ReadIdentity: %+v", id)
+}
+
+func TestCaches(t *testing.T) {
+	caches := cpu.Caches()
+	if len(caches) == 0 {
+		t.Skip("caches not reported")
+	}
+	for i, c := range caches {
+		t.Logf("L%d %v: size %d, line %d, %d-way, shared by %d", c.Level, c.Type, c.Size, c.LineSize, c.Ways, c.SharedBy)
+		if c.Level < 1 {
+			t.Errorf("cache %d: level %d", i, c.Level)
+		}
+		if i > 0 && c.Level < caches[i-1].Level {
+			t.Errorf("cache %d: not ordered by level", i)
+		}
+		if c.LineSize < 0 || c.LineSize&(c.LineSize-1) != 0 {
+			t.Errorf("cache %d: line size %d is not a power of two", i, c.LineSize)
+		}
+	}
+	if n := cpu.CacheLineSize(); n <= 0 {
+		t.Errorf("CacheLineSize() = %d", n)
+	}
+	t.Logf("current core type: %v", cpu.CurrentCoreType())
+}
+
+func TestFeatures(t *testing.T) {
+	features := cpu.Features()
+	for i, f := range features {
+		if i > 0 && f.Name <= features[i-1].Name {
+			t.Errorf("feature %q not sorted or duplicated", f.Name)
+		}
+		if f.Masked != (f.Detected && !f.Enabled) {
+			t.Errorf("%v: detected %v, enabled %v, masked %v", f, f.Detected, f.Enabled, f.Masked)
+		}
+	}
+	if len(cpu.GODEBUGErrors()) != 0 {
+		t.Logf("GODEBUG errors: %v", cpu.GODEBUGErrors())
+	}
+}
+
 func TestARM64minimalFeatures(t *testing.T) {
 	if runtime.GOARCH != "arm64" || runtime.GOOS == "ios" {
 		return
@@ -87,6 +165,24 @@ func TestARM64minimalFeatures(t *testing.T) {
 	}
 }
 
+func TestARM64SVE(t *testing.T) {
+	if runtime.GOARCH != "arm64" {
+		return
+	}
+	if cpu.ARM64.HasSVE2 && !cpu.ARM64.HasSVE {
+		t.Error("HasSVE expected true, got false")
+	}
+	if (cpu.ARM64.HasSVE2AES || cpu.ARM64.HasSVE2BITPERM || cpu.ARM64.HasSVE2SHA3 || cpu.ARM64.HasSVE2SM4) && !cpu.ARM64.HasSVE2 {
+		t.Error("HasSVE2 expected true, got false")
+	}
+	if cpu.ARM64.HasSME2 && !cpu.ARM64.HasSME {
+		t.Error("HasSME expected true, got false")
+	}
+	if runtime.GOOS == "linux" && cpu.ARM64.HasSVE && (cpu.ARM64.SVEVL == 0 || cpu.ARM64.SVEVL%16 != 0) {
+		t.Errorf("SVEVL = %d, want a non-zero multiple of 16", cpu.ARM64.SVEVL)
+	}
+}
+
 func TestLOONG64Initialized(t *testing.T) {
 	if runtime.GOARCH == "loong64" {
 		if !cpu.Initialized {
@@ -117,6 +213,14 @@ func TestRISCV64VectorLength(t *testing.T) {
 	}
 }
 
+func TestRISCV64ZicbozBlockSize(t *testing.T) {
+	if runtime.GOARCH == "riscv64" && cpu.RISCV64.HasZicboz {
+		if n := cpu.RISCV64.ZicbozBlockSize; n == 0 || n&(n-1) != 0 {
+			t.Fatalf("ZicbozBlockSize = %d, want a power of two", n)
+		}
+	}
+}
+
 // On ppc64x, the ISA bit for POWER8 should always be set on POWER8 and beyond.
 func TestPPC64minimalFeatures(t *testing.T) {
 	// Do not run this with gccgo on ppc64, as it doesn't have POWER8 as a minimum
@@ -46,6 +46,7 @@ func initOptions() {
 		{Name: "fma", Feature: &X86.HasFMA},
 		{Name: "osxsave", Feature: &X86.HasOSXSAVE},
 		{Name: "pclmulqdq", Feature: &X86.HasPCLMULQDQ},
+		{Name: "pku", Feature: &X86.HasPKU},
 		{Name: "popcnt", Feature: &X86.HasPOPCNT},
 		{Name: "rdrand", Feature: &X86.HasRDRAND},
 		{Name: "rdseed", Feature: &X86.HasRDSEED},
@@ -56,6 +57,19 @@ func initOptions() {
 		{Name: "avxifma", Feature: &X86.HasAVXIFMA},
 		{Name: "avxvnni", Feature: &X86.HasAVXVNNI},
 		{Name: "avxvnniint8", Feature: &X86.HasAVXVNNIInt8},
+		{Name: "avx10", Feature: &X86.HasAVX10},
+		{Name: "avx10_256", Feature: &X86.HasAVX10_256},
+		{Name: "avx10_512", Feature: &X86.HasAVX10_512},
+		{Name: "apx", Feature: &X86.HasAPX},
+		{Name: "cetibt", Feature: &X86.HasCETIBT},
+		{Name: "cetss", Feature: &X86.HasCETSS},
+		{Name: "f16c", Feature: &X86.HasF16C},
+		{Name: "gfni", Feature: &X86.HasGFNI},
+		{Name: "lzcnt", Feature: &X86.HasLZCNT},
+		{Name: "movbe", Feature: &X86.HasMOVBE},
+		{Name: "sha", Feature: &X86.HasSHA},
+		{Name: "vaes", Feature: &X86.HasVAES},
+		{Name: "vpclmulqdq", Feature: &X86.HasVPCLMULQDQ},
 
 		// These capabilities should always be enabled on amd64:
 		{Name: "sse2", Feature: &X86.HasSSE2, Required: runtime.GOARCH == "amd64"},
@@ -119,34 +133,70 @@ func archInit() {
 		cpuid_SSE2 = 1 << 26
 		// eax=1: ecx
 		cpuid_CX16   = 1 << 13
+		cpuid_MOVBE  = 1 << 22
+		cpuid_F16C   = 1 << 29
 		cpuid_RDRAND = 1 << 30
 		// eax=7,ecx=0: ebx
 		cpuid_RDSEED     = 1 << 18
 		cpuid_AVX512IFMA = 1 << 21
 		cpuid_AVX512PF   = 1 << 26
 		cpuid_AVX512ER   = 1 << 27
+		// eax=7,ecx=0: ecx
+		cpuid_PKU        = 1 << 3
+		cpuid_OSPKE      = 1 << 4
+		cpuid_CETSS      = 1 << 7
+		cpuid_VAES       = 1 << 9
+		cpuid_VPCLMULQDQ = 1 << 10
 		// eax=7,ecx=0: edx
 		cpuid_AVX5124VNNIW = 1 << 2
 		cpuid_AVX5124FMAPS = 1 << 3
 		cpuid_AMXBF16      = 1 << 22
 		cpuid_AMXTile      = 1 << 24
 		cpuid_AMXInt8      = 1 << 25
+		cpuid_CETIBT       = 1 << 20
 		// eax=7,ecx=1: eax
 		cpuid_AVX512BF16 = 1 << 5
 		cpuid_AVXIFMA    = 1 << 23
 		// eax=7,ecx=1: edx
 		cpuid_AVXVNNIInt8 = 1 << 4
+		cpuid_AVX10       = 1 << 19
+		cpuid_APX_F       = 1 << 21
+		// eax=0x24,ecx=0: ebx
+		cpuid_AVX10_256 = 1 << 17
+		cpuid_AVX10_512 = 1 << 18
+		// eax=0x80000001: ecx
+		cpuid_LAHF  = 1 << 0
+		cpuid_LZCNT = 1 << 5
+		// eax=0x80000001: edx
+		cpuid_LM = 1 << 29
 	)
 
 	Initialized = true
 
-	maxID, _, _, _ := cpuid(0, 0)
+	doDerived = func() {
+		// AVX10 uses the AVX-512 register state, so disabling AVX-512
+		// with GODEBUG disables it too.
+		if godebugMasked(&X86.HasAVX512F) || godebugMasked(&X86.HasAVX512) {
+			X86.HasAVX10 = false
+		}
+		// If AVX10 is disabled by GODEBUG, so are its vector lengths.
+		if !X86.HasAVX10 {
+			X86.HasAVX10_256 = false
+			X86.HasAVX10_512 = false
+			X86.AVX10Version = 0
+		}
+		X86.Level = x86Level()
+	}
+
+	maxID, ebx0, ecx0, edx0 := cpuid(0, 0)
+	ID.Vendor = cpuidString(ebx0, edx0, ecx0)
 
 	if maxID < 1 {
 		return
 	}
 
-	_, _, ecx1, edx1 := cpuid(1, 0)
+	eax1, _, ecx1, edx1 := cpuid(1, 0)
+	ID.Family, ID.Model, ID.Stepping = x86Signature(eax1)
 	X86.HasSSE2 = isSet(edx1, cpuid_SSE2)
 
 	X86.HasSSE3 = isSet(ecx1, cpuid_SSE3)
@@ -160,8 +210,9 @@ func archInit() {
 	X86.HasAES = isSet(ecx1, cpuid_AES)
 	X86.HasOSXSAVE = isSet(ecx1, cpuid_OSXSAVE)
 	X86.HasRDRAND = isSet(ecx1, cpuid_RDRAND)
+	X86.HasMOVBE = isSet(ecx1, cpuid_MOVBE)
 
-	var osSupportsAVX, osSupportsAVX512 bool
+	var osSupportsAVX, osSupportsAVX512, osSupportsAPX bool
 	// For XGETBV, OSXSAVE bit is required and sufficient.
 	if X86.HasOSXSAVE {
 		eax, _ := xgetbv()
@@ -175,9 +226,27 @@ func archInit() {
 			// Check if OPMASK and ZMM registers have OS support.
 			osSupportsAVX512 = osSupportsAVX && isSet(eax, 1<<5) && isSet(eax, 1<<6) && isSet(eax, 1<<7)
 		}
+		// Check if the extended general purpose registers have OS support.
+		osSupportsAPX = isSet(eax, 1<<19)
 	}
 
 	X86.HasAVX = isSet(ecx1, cpuid_AVX) && osSupportsAVX
+	X86.HasF16C = isSet(ecx1, cpuid_F16C) && osSupportsAVX
+
+	maxExtendedID, _, _, _ := cpuid(0x80000000, 0)
+	if maxExtendedID >= 0x80000001 {
+		_, _, ecx, edx := cpuid(0x80000001, 0)
+		X86.HasLZCNT = isSet(ecx, cpuid_LZCNT)
+		x86HasLAHF = isSet(ecx, cpuid_LAHF)
+		x86HasLongMode = isSet(edx, cpuid_LM)
+	}
+	if maxExtendedID >= 0x80000004 {
+		var regs [12]uint32
+		for i := range 3 {
+			regs[4*i], regs[4*i+1], regs[4*i+2], regs[4*i+3] = cpuid(0x80000002+uint32(i), 0)
+		}
+		ID.Brand = cpuidString(regs[:]...)
+	}
 
 	if maxID < 7 {
 		return
@@ -190,6 +259,13 @@ func archInit() {
 	X86.HasERMS = isSet(ebx7, cpuid_ERMS)
 	X86.HasRDSEED = isSet(ebx7, cpuid_RDSEED)
 	X86.HasADX = isSet(ebx7, cpuid_ADX)
+	X86.HasSHA = isSet(ebx7, cpuid_SHA)
+	X86.HasGFNI = isSet(ecx7, cpuid_GFNI)
+	X86.HasVAES = isSet(ecx7, cpuid_VAES) && osSupportsAVX
+	X86.HasVPCLMULQDQ = isSet(ecx7, cpuid_VPCLMULQDQ) && osSupportsAVX
+	X86.HasCETSS = isSet(ecx7, cpuid_CETSS)
+	X86.HasCETIBT = isSet(edx7, cpuid_CETIBT)
+	X86.HasPKU = isSet(ecx7, cpuid_PKU) && isSet(ecx7, cpuid_OSPKE)
 
 	X86.HasAVX512 = isSet(ebx7, cpuid_AVX512F) && osSupportsAVX512 // Because avx-512 foundation is the core required extension
 	if X86.HasAVX512 {
@@ -228,7 +304,44 @@ func archInit() {
 			X86.HasAVXVNNI = isSet(eax71, cpuid_AVXVNNI)
 			X86.HasAVXVNNIInt8 = isSet(edx71, cpuid_AVXVNNIInt8)
 		}
+		X86.HasAPX = isSet(edx71, cpuid_APX_F) && osSupportsAPX
+		// AVX10 uses the AVX-512 register state and describes itself in
+		// leaf 0x24.
+		if isSet(edx71, cpuid_AVX10) && osSupportsAVX512 && maxID >= 0x24 {
+			_, ebx24, _, _ := cpuid(0x24, 0)
+			X86.HasAVX10 = true
+			X86.AVX10Version = int(ebx24 & 0xff)
+			X86.HasAVX10_256 = isSet(ebx24, cpuid_AVX10_256)
+			X86.HasAVX10_512 = isSet(ebx24, cpuid_AVX10_512)
+		}
+	}
+}
+
+// Features that are only needed to compute X86.Level.
+var x86HasLAHF, x86HasLongMode bool
+
+// x86Level returns the highest x86-64 microarchitecture level, as defined by
+// the x86-64 psABI and used by GOAMD64, whose features are all available.
+// The long mode bit only reports what the CPU supports, so a 386 program
+// running on a 64-bit CPU has level 0 too.
+func x86Level() int {
+	if runtime.GOARCH != "amd64" || !x86HasLongMode || !X86.HasSSE2 {
+		return 0
+	}
+	if !X86.HasCX16 || !x86HasLAHF || !X86.HasPOPCNT || !X86.HasSSE3 ||
+		!X86.HasSSE41 || !X86.HasSSE42 || !X86.HasSSSE3 {
+		return 1
+	}
+	if !X86.HasAVX || !X86.HasAVX2 || !X86.HasBMI1 || !X86.HasBMI2 ||
+		!X86.HasF16C || !X86.HasFMA || !X86.HasLZCNT || !X86.HasMOVBE ||
+		!X86.HasOSXSAVE {
+		return 2
+	}
+	if !X86.HasAVX512F || !X86.HasAVX512BW || !X86.HasAVX512CD ||
+		!X86.HasAVX512DQ || !X86.HasAVX512VL {
+		return 3
 	}
+	return 4
 }
 
 func isSet(hwc uint32, value uint32) bool {
@@ -0,0 +1,44 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build 386 || amd64 || amd64p32
+
+package cpu
+
+import "testing"
+
+func TestAVX10DisabledWithAVX512F(t *testing.T) {
+	savedX86, savedOptions, savedErrors := X86, options, godebugErrors
+	defer func() { X86, options, godebugErrors = savedX86, savedOptions, savedErrors }()
+
+	X86.HasAVX512, X86.HasAVX512F = true, true
+	X86.HasAVX10, X86.HasAVX10_256, X86.HasAVX10_512, X86.AVX10Version = true, true, true, 1
+	options = []option{
+		{Name: "avx512", Feature: &X86.HasAVX512},
+		{Name: "avx512f", Feature: &X86.HasAVX512F},
+		{Name: "avx10", Feature: &X86.HasAVX10},
+	}
+	processOptions("cpu.avx512f=off")
+	doDerived()
+	if X86.HasAVX10 || X86.HasAVX10_256 || X86.HasAVX10_512 || X86.AVX10Version != 0 {
+		t.Errorf("AVX10 still enabled with cpu.avx512f=off: %+v", X86)
+	}
+}
+
+func TestAVX10VectorLengthOptions(t *testing.T) {
+	savedX86, savedOptions, savedErrors := X86, options, godebugErrors
+	defer func() { X86, options, godebugErrors = savedX86, savedOptions, savedErrors }()
+
+	X86.HasAVX10, X86.HasAVX10_256, X86.HasAVX10_512, X86.AVX10Version = true, true, true, 1
+	options = []option{
+		{Name: "avx10", Feature: &X86.HasAVX10},
+		{Name: "avx10_256", Feature: &X86.HasAVX10_256},
+		{Name: "avx10_512", Feature: &X86.HasAVX10_512},
+	}
+	processOptions("cpu.avx10_512=off")
+	doDerived()
+	if !X86.HasAVX10 || !X86.HasAVX10_256 || X86.HasAVX10_512 || X86.AVX10Version != 1 {
+		t.Errorf("cpu.avx10_512=off: got %+v", X86)
+	}
+}
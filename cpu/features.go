@@ -0,0 +1,86 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cpu
+
+import (
+	"slices"
+	"strings"
+)
+
+// Feature describes a CPU feature of the current architecture that can be
+// controlled with a GODEBUG=cpu.<name>=on|off setting.
+type Feature struct {
+	Name     string `json:"name"`     // Option name, e.g. "avx2"
+	Detected bool   `json:"detected"` // Supported by the processor and operating system
+	Enabled  bool   `json:"enabled"`  // Reported as available, after applying GODEBUG
+	Masked   bool   `json:"masked"`   // Detected but disabled by GODEBUG
+	Required bool   `json:"required"` // Mandatory for GOARCH, cannot be disabled
+}
+
+// String returns the name and state of f, e.g. "avx2: enabled".
+func (f Feature) String() string {
+	state := "enabled"
+	switch {
+	case f.Masked:
+		state = "disabled by GODEBUG"
+	case !f.Enabled:
+		state = "not detected"
+	}
+	if f.Required {
+		state += " (required)"
+	}
+	return f.Name + ": " + state
+}
+
+// FeatureList is a list of features. It can be encoded as JSON with
+// encoding/json.
+type FeatureList []Feature
+
+// String returns the features of l, one per line.
+func (l FeatureList) String() string {
+	var b strings.Builder
+	for _, f := range l {
+		b.WriteString(f.String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// Enabled returns the names of the enabled features of l.
+func (l FeatureList) Enabled() []string {
+	var names []string
+	for _, f := range l {
+		if f.Enabled {
+			names = append(names, f.Name)
+		}
+	}
+	return names
+}
+
+// Features returns the features of the current architecture that can be
+// controlled with GODEBUG, sorted by name. It returns nil on architectures
+// without such features.
+func Features() FeatureList {
+	var l FeatureList
+	for _, o := range options {
+		l = append(l, Feature{
+			Name:     o.Name,
+			Detected: o.Detected,
+			Enabled:  *o.Feature,
+			Masked:   o.Detected && !*o.Feature,
+			Required: o.Required,
+		})
+	}
+	slices.SortFunc(l, func(a, b Feature) int { return strings.Compare(a.Name, b.Name) })
+	return l
+}
+
+// GODEBUGErrors returns the problems found in the cpu.* settings of the
+// GODEBUG environment variable when the package was initialized, such as
+// unknown features or features that cannot be enabled for lack of CPU
+// support. Such settings are otherwise ignored.
+func GODEBUGErrors() []error {
+	return slices.Clone(godebugErrors)
+}
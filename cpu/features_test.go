@@ -0,0 +1,68 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cpu
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestProcessOptions(t *testing.T) {
+	savedOptions, savedErrors := options, godebugErrors
+	defer func() { options, godebugErrors = savedOptions, savedErrors }()
+
+	a, b, c, d := true, true, false, true
+	options = []option{
+		{Name: "a", Feature: &a},
+		{Name: "b", Feature: &b},
+		{Name: "c", Feature: &c},
+		{Name: "d", Feature: &d, Required: true},
+	}
+	godebugErrors = nil
+	processOptions("gctrace=1,cpu.b=off,cpu.c=on,cpu.d=off,cpu.x=off,cpu.a=maybe,cpu.a")
+
+	want := FeatureList{
+		{Name: "a", Detected: true, Enabled: true},
+		{Name: "b", Detected: true, Masked: true},
+		{Name: "c"},
+		{Name: "d", Detected: true, Enabled: true, Required: true},
+	}
+	got := Features()
+	if !slices.Equal(got, want) {
+		t.Errorf("Features() = %v, want %v", got, want)
+	}
+	if s, want := got.String(), "a: enabled\nb: disabled by GODEBUG\nc: not detected\nd: enabled (required)\n"; s != want {
+		t.Errorf("String() = %q, want %q", s, want)
+	}
+	if names := got.Enabled(); !slices.Equal(names, []string{"a", "d"}) {
+		t.Errorf("Enabled() = %q, want [a d]", names)
+	}
+
+	var errs []string
+	for _, err := range GODEBUGErrors() {
+		errs = append(errs, err.Error())
+	}
+	wantErrs := []string{
+		`GODEBUG sys/cpu: unknown cpu feature "x"`,
+		`GODEBUG sys/cpu: value "maybe" not supported for cpu option "a"`,
+		`GODEBUG sys/cpu: no value specified for "cpu.a"`,
+		`GODEBUG sys/cpu: can not enable "c", missing CPU support`,
+		`GODEBUG sys/cpu: can not disable "d", required CPU feature`,
+	}
+	if !slices.Equal(errs, wantErrs) {
+		t.Errorf("GODEBUGErrors() = %q, want %q", errs, wantErrs)
+	}
+
+	j, err := json.Marshal(got[:2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantJSON := `[{"name":"a","detected":true,"enabled":true,"masked":false,"required":false},` +
+		`{"name":"b","detected":true,"enabled":false,"masked":true,"required":false}]`
+	if string(j) != wantJSON {
+		t.Errorf("json.Marshal = %s, want %s", j, wantJSON)
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cpu
+
+import (
+	"strings"
+	"sync"
+)
+
+// Identity identifies a processor model.
+//
+// On x86 it is populated from CPUID on all operating systems. On arm64 it
+// is populated from the MIDR_EL1 register on NetBSD, and on Linux when the
+// kernel emulates reads of ID registers (see ARM64.HasCPUID); otherwise
+// [ReadIdentity] reads it from sysfs on Linux. Fields that cannot be
+// determined are left zero.
+type Identity struct {
+	Vendor string // Vendor or implementer name, e.g. "GenuineIntel", "AuthenticAMD" or "Arm"
+	Brand  string // Brand string on x86, part name on arm64 if known
+
+	// x86 only.
+	Family   int // Family, including the extended family
+	Model    int // Model, including the extended model
+	Stepping int // Stepping
+
+	// arm64 only.
+	MIDR        uint64 // Main ID Register (MIDR_EL1)
+	Implementer int    // Implementer code
+	Variant     int    // Major revision
+	Part        int    // Part number
+	Revision    int    // Minor revision
+}
+
+// ID identifies the processor the program is running on. On systems with
+// processors of several kinds, such as big.LITTLE arm64 systems, it may
+// describe any one of them.
+var ID Identity
+
+// ReadIdentity returns ID, with the fields it could not populate read from
+// files if possible. Unlike ID, which is set when the package is
+// initialized, it may read sysfs on Linux the first time it is called.
+func ReadIdentity() Identity {
+	return identity()
+}
+
+var identity = sync.OnceValue(readIdentity)
+
+// setMIDR sets the arm64 fields of id from the value of MIDR_EL1.
+func (id *Identity) setMIDR(midr uint64) {
+	id.MIDR = midr
+	id.Implementer = int(midr>>24) & 0xff
+	id.Variant = int(midr>>20) & 0xf
+	id.Part = int(midr>>4) & 0xfff
+	id.Revision = int(midr) & 0xf
+	if impl, ok := arm64Implementers[id.Implementer]; ok {
+		id.Vendor = impl.name
+		id.Brand = impl.parts[id.Part]
+	}
+}
+
+// arm64Implementers lists common arm64 implementers and their parts, as
+// in the Linux kernel's arch/arm64/include/asm/cputype.h.
+var arm64Implementers = map[int]struct {
+	name  string
+	parts map[int]string
+}{
+	0x41: {"Arm", map[int]string{
+		0xd03: "Cortex-A53",
+		0xd04: "Cortex-A35",
+		0xd05: "Cortex-A55",
+		0xd07: "Cortex-A57",
+		0xd08: "Cortex-A72",
+		0xd09: "Cortex-A73",
+		0xd0a: "Cortex-A75",
+		0xd0b: "Cortex-A76",
+		0xd0c: "Neoverse-N1",
+		0xd0d: "Cortex-A77",
+		0xd40: "Neoverse-V1",
+		0xd41: "Cortex-A78",
+		0xd44: "Cortex-X1",
+		0xd46: "Cortex-A510",
+		0xd47: "Cortex-A710",
+		0xd48: "Cortex-X2",
+		0xd49: "Neoverse-N2",
+		0xd4a: "Neoverse-E1",
+		0xd4b: "Cortex-A78C",
+		0xd4d: "Cortex-A715",
+		0xd4e: "Cortex-X3",
+		0xd4f: "Neoverse-V2",
+		0xd80: "Cortex-A520",
+		0xd81: "Cortex-A720",
+		0xd82: "Cortex-X4",
+		0xd84: "Neoverse-V3",
+		0xd8e: "Neoverse-N3",
+	}},
+	0x42: {"Broadcom", map[int]string{
+		0x516: "Vulcan",
+	}},
+	0x43: {"Cavium", map[int]string{
+		0x0a1: "ThunderX",
+		0x0a2: "ThunderX 81XX",
+		0x0a3: "ThunderX 83XX",
+		0x0af: "ThunderX2",
+	}},
+	0x46: {"Fujitsu", map[int]string{
+		0x001: "A64FX",
+	}},
+	0x48: {"HiSilicon", map[int]string{
+		0xd01: "TSV110",
+	}},
+	0x4e: {"NVIDIA", map[int]string{
+		0x003: "Denver",
+		0x004: "Carmel",
+	}},
+	0x50: {"Applied Micro", map[int]string{
+		0x000: "X-Gene",
+	}},
+	0x51: {"Qualcomm", map[int]string{
+		0x001: "Oryon",
+		0x800: "Kryo 2XX Gold",
+		0x801: "Kryo 2XX Silver",
+		0x802: "Kryo 3XX Gold",
+		0x803: "Kryo 3XX Silver",
+		0x804: "Kryo 4XX Gold",
+		0x805: "Kryo 4XX Silver",
+		0xc00: "Falkor",
+	}},
+	0x53: {"Samsung", map[int]string{
+		0x001: "Exynos M1",
+	}},
+	0x61: {"Apple", map[int]string{
+		0x022: "M1 Icestorm",
+		0x023: "M1 Firestorm",
+		0x024: "M1 Pro Icestorm",
+		0x025: "M1 Pro Firestorm",
+		0x028: "M1 Max Icestorm",
+		0x029: "M1 Max Firestorm",
+		0x032: "M2 Blizzard",
+		0x033: "M2 Avalanche",
+	}},
+	0xc0: {"Ampere", map[int]string{
+		0xac3: "AmpereOne",
+	}},
+}
+
+// x86Signature decodes the family, model and stepping from the processor
+// signature returned in EAX by CPUID leaf 1.
+func x86Signature(eax uint32) (family, model, stepping int) {
+	family = int(eax>>8) & 0xf
+	model = int(eax>>4) & 0xf
+	stepping = int(eax) & 0xf
+	if family == 0x6 || family == 0xf {
+		model += (int(eax>>16) & 0xf) << 4
+	}
+	if family == 0xf {
+		family += int(eax>>20) & 0xff
+	}
+	return family, model, stepping
+}
+
+// parseHex parses a hexadecimal number with an optional 0x prefix, as
+// found in sysfs.
+func parseHex(s string) (uint64, bool) {
+	s = strings.TrimPrefix(s, "0x")
+	if s == "" || len(s) > 16 {
+		return 0, false
+	}
+	var v uint64
+	for _, c := range []byte(s) {
+		switch {
+		case '0' <= c && c <= '9':
+			c -= '0'
+		case 'a' <= c && c <= 'f':
+			c -= 'a' - 10
+		case 'A' <= c && c <= 'F':
+			c -= 'A' - 10
+		default:
+			return 0, false
+		}
+		v = v<<4 | uint64(c)
+	}
+	return v, true
+}
+
+// cpuidString returns the characters stored in the CPUID output registers
+// regs, up to the first NUL and without surrounding spaces.
+func cpuidString(regs ...uint32) string {
+	b := make([]byte, 0, 4*len(regs))
+	for _, r := range regs {
+		b = append(b, byte(r), byte(r>>8), byte(r>>16), byte(r>>24))
+	}
+	s := string(b)
+	if i := strings.IndexByte(s, 0); i >= 0 {
+		s = s[:i]
+	}
+	return strings.TrimSpace(s)
+}
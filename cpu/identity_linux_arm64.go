@@ -0,0 +1,17 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cpu
+
+// readIdentity returns ID, with the MIDR_EL1 register of the first CPU read
+// from sysfs if the kernel does not emulate reading it.
+func readIdentity() Identity {
+	id := ID
+	if id.MIDR == 0 {
+		if midr, ok := parseHex(readSysfsString("/sys/devices/system/cpu/cpu0/regs/identification/midr_el1")); ok {
+			id.setMIDR(midr)
+		}
+	}
+	return id
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cpu
+
+import "testing"
+
+func TestX86Signature(t *testing.T) {
+	for _, tt := range []struct {
+		eax                     uint32
+		family, model, stepping int
+	}{
+		{0x000806f8, 6, 0x8f, 8},    // Intel Sapphire Rapids
+		{0x00a10f11, 0x19, 0x11, 1}, // AMD Genoa
+		{0x00000f29, 0xf, 0x2, 9},   // Intel Pentium 4
+		{0x00000543, 5, 4, 3},       // Intel Pentium MMX
+	} {
+		family, model, stepping := x86Signature(tt.eax)
+		if family != tt.family || model != tt.model || stepping != tt.stepping {
+			t.Errorf("x86Signature(%#x) = %#x, %#x, %d; want %#x, %#x, %d",
+				tt.eax, family, model, stepping, tt.family, tt.model, tt.stepping)
+		}
+	}
+}
+
+func TestSetMIDR(t *testing.T) {
+	midr, ok := parseHex("0x00000000410fd0c1")
+	if !ok {
+		t.Fatal("parseHex failed")
+	}
+	var id Identity
+	id.setMIDR(midr)
+	want := Identity{
+		Vendor:      "Arm",
+		Brand:       "Neoverse-N1",
+		MIDR:        0x410fd0c1,
+		Implementer: 0x41,
+		Variant:     0,
+		Part:        0xd0c,
+		Revision:    1,
+	}
+	if id != want {
+		t.Errorf("setMIDR(%#x): got %+v, want %+v", midr, id, want)
+	}
+
+	for _, s := range []string{"", "0x", "0xfoo", "0x00000000410fd0c1ff"} {
+		if _, ok := parseHex(s); ok {
+			t.Errorf("parseHex(%q): expected failure", s)
+		}
+	}
+}
+
+func TestCPUIDString(t *testing.T) {
+	// CPUID leaf 0 returns the vendor in EBX, EDX, ECX.
+	if got := cpuidString(0x756e6547, 0x49656e69, 0x6c65746e); got != "GenuineIntel" {
+		t.Errorf("cpuidString: got %q, want %q", got, "GenuineIntel")
+	}
+	if got := cpuidString(0x20202020, 0x00434241); got != "ABC" {
+		t.Errorf("cpuidString: got %q, want %q", got, "ABC")
+	}
+}
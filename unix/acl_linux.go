@@ -0,0 +1,404 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ACLEntry is a single entry of a POSIX access control list. Tag is one of
+// the ACL_USER_OBJ, ACL_USER, ACL_GROUP_OBJ, ACL_GROUP, ACL_MASK or
+// ACL_OTHER constants, Perm is a combination of ACL_READ, ACL_WRITE and
+// ACL_EXECUTE, and ID is the user or group ID for ACL_USER and ACL_GROUP
+// entries. ID is ignored for other entries.
+type ACLEntry struct {
+	Tag  uint16
+	Perm uint16
+	ID   uint32
+}
+
+// ACL is a POSIX access control list as stored in the
+// system.posix_acl_access and system.posix_acl_default extended
+// attributes. See the acl(5) man page for details.
+type ACL []ACLEntry
+
+const (
+	sizeofACLHeader = 4
+	sizeofACLEntry  = 8
+	aclUndefinedID  = ^uint32(0)
+)
+
+// ACLFromMode returns the minimal ACL equivalent to the permission bits of
+// mode, consisting of ACL_USER_OBJ, ACL_GROUP_OBJ and ACL_OTHER entries.
+func ACLFromMode(mode uint32) ACL {
+	return ACL{
+		{Tag: ACL_USER_OBJ, Perm: uint16(mode>>6) & 7, ID: aclUndefinedID},
+		{Tag: ACL_GROUP_OBJ, Perm: uint16(mode>>3) & 7, ID: aclUndefinedID},
+		{Tag: ACL_OTHER, Perm: uint16(mode) & 7, ID: aclUndefinedID},
+	}
+}
+
+// DecodeACL decodes an ACL in the binary posix_acl_xattr format used by
+// the system.posix_acl_* extended attributes.
+func DecodeACL(b []byte) (ACL, error) {
+	if len(b) < sizeofACLHeader || (len(b)-sizeofACLHeader)%sizeofACLEntry != 0 {
+		return nil, EINVAL
+	}
+	if aclUint32(b) != POSIX_ACL_XATTR_VERSION {
+		return nil, EOPNOTSUPP
+	}
+	b = b[sizeofACLHeader:]
+	acl := make(ACL, 0, len(b)/sizeofACLEntry)
+	for ; len(b) > 0; b = b[sizeofACLEntry:] {
+		acl = append(acl, ACLEntry{
+			Tag:  uint16(b[0]) | uint16(b[1])<<8,
+			Perm: uint16(b[2]) | uint16(b[3])<<8,
+			ID:   aclUint32(b[4:]),
+		})
+	}
+	return acl, nil
+}
+
+// aclUint32 decodes a little-endian uint32. The posix_acl_xattr format is
+// little-endian regardless of the host byte order.
+func aclUint32(b []byte) uint32 {
+	return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24
+}
+
+// Encode returns a in the binary posix_acl_xattr format. The entries are
+// written in the canonical order expected by the kernel, sorted by tag and
+// then by ID.
+func (a ACL) Encode() []byte {
+	s := slices.Clone(a)
+	s.Sort()
+	b := make([]byte, 0, sizeofACLHeader+len(s)*sizeofACLEntry)
+	b = appendLE32(b, POSIX_ACL_XATTR_VERSION)
+	for _, e := range s {
+		id := e.ID
+		if e.Tag != ACL_USER && e.Tag != ACL_GROUP {
+			id = aclUndefinedID
+		}
+		b = append(b, byte(e.Tag), byte(e.Tag>>8), byte(e.Perm), byte(e.Perm>>8))
+		b = appendLE32(b, id)
+	}
+	return b
+}
+
+func appendLE32(b []byte, v uint32) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+// Sort sorts the entries of a by tag and then by ID.
+func (a ACL) Sort() {
+	slices.SortStableFunc(a, func(x, y ACLEntry) int {
+		if x.Tag != y.Tag {
+			return int(x.Tag) - int(y.Tag)
+		}
+		if x.ID < y.ID {
+			return -1
+		} else if x.ID > y.ID {
+			return 1
+		}
+		return 0
+	})
+}
+
+// Valid reports whether a is a valid ACL: it must contain exactly one
+// ACL_USER_OBJ, ACL_GROUP_OBJ and ACL_OTHER entry, at most one ACL_MASK
+// entry, which is required if there are any ACL_USER or ACL_GROUP entries,
+// and no two ACL_USER or ACL_GROUP entries for the same ID.
+func (a ACL) Valid() error {
+	var n [ACL_OTHER + 1]int
+	users := make(map[uint32]bool)
+	groups := make(map[uint32]bool)
+	for _, e := range a {
+		if e.Perm&^(ACL_READ|ACL_WRITE|ACL_EXECUTE) != 0 {
+			return EINVAL
+		}
+		switch e.Tag {
+		case ACL_USER_OBJ, ACL_GROUP_OBJ, ACL_MASK, ACL_OTHER:
+		case ACL_USER:
+			if users[e.ID] {
+				return EINVAL
+			}
+			users[e.ID] = true
+		case ACL_GROUP:
+			if groups[e.ID] {
+				return EINVAL
+			}
+			groups[e.ID] = true
+		default:
+			return EINVAL
+		}
+		n[e.Tag]++
+	}
+	if n[ACL_USER_OBJ] != 1 || n[ACL_GROUP_OBJ] != 1 || n[ACL_OTHER] != 1 || n[ACL_MASK] > 1 {
+		return EINVAL
+	}
+	if n[ACL_MASK] == 0 && len(users)+len(groups) > 0 {
+		return EINVAL
+	}
+	return nil
+}
+
+// index returns the index of the first entry in a with the given tag, or
+// -1 if there is none.
+func (a ACL) index(tag uint16) int {
+	return slices.IndexFunc(a, func(e ACLEntry) bool { return e.Tag == tag })
+}
+
+// CalcMask sets the ACL_MASK entry of a to the union of the permissions of
+// all ACL_USER, ACL_GROUP_OBJ and ACL_GROUP entries, as setfacl does. A
+// mask entry is added if a has ACL_USER or ACL_GROUP entries but no mask;
+// it is never removed.
+func (a *ACL) CalcMask() {
+	var perm uint16
+	extended := false
+	for _, e := range *a {
+		switch e.Tag {
+		case ACL_USER, ACL_GROUP:
+			extended = true
+			fallthrough
+		case ACL_GROUP_OBJ:
+			perm |= e.Perm
+		}
+	}
+	if i := a.index(ACL_MASK); i >= 0 {
+		(*a)[i].Perm = perm
+	} else if extended {
+		*a = append(*a, ACLEntry{Tag: ACL_MASK, Perm: perm, ID: aclUndefinedID})
+	}
+}
+
+// Mode returns the permission bits of the file mode that correspond to a.
+// The group bits are taken from the ACL_MASK entry if there is one and from
+// the ACL_GROUP_OBJ entry otherwise, as described in acl(7).
+func (a ACL) Mode() uint32 {
+	var mode uint32
+	if i := a.index(ACL_USER_OBJ); i >= 0 {
+		mode |= uint32(a[i].Perm&7) << 6
+	}
+	if i := a.index(ACL_MASK); i >= 0 {
+		mode |= uint32(a[i].Perm&7) << 3
+	} else if i := a.index(ACL_GROUP_OBJ); i >= 0 {
+		mode |= uint32(a[i].Perm&7) << 3
+	}
+	if i := a.index(ACL_OTHER); i >= 0 {
+		mode |= uint32(a[i].Perm & 7)
+	}
+	return mode
+}
+
+// SetMode updates a to reflect the permission bits of mode, as the kernel
+// does on chmod(2). The group bits are applied to the ACL_MASK entry if
+// there is one and to the ACL_GROUP_OBJ entry otherwise.
+func (a ACL) SetMode(mode uint32) {
+	set := func(tag uint16, perm uint32) bool {
+		if i := a.index(tag); i >= 0 {
+			a[i].Perm = uint16(perm & 7)
+			return true
+		}
+		return false
+	}
+	set(ACL_USER_OBJ, mode>>6)
+	if !set(ACL_MASK, mode>>3) {
+		set(ACL_GROUP_OBJ, mode>>3)
+	}
+	set(ACL_OTHER, mode)
+}
+
+// IsMinimal reports whether a is equivalent to the file mode bits, that
+// is, it only has ACL_USER_OBJ, ACL_GROUP_OBJ and ACL_OTHER entries.
+func (a ACL) IsMinimal() bool {
+	for _, e := range a {
+		switch e.Tag {
+		case ACL_USER_OBJ, ACL_GROUP_OBJ, ACL_OTHER:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+var aclTagNames = [...]struct {
+	tag  uint16
+	name string
+}{
+	{ACL_USER_OBJ, "user"},
+	{ACL_USER, "user"},
+	{ACL_GROUP_OBJ, "group"},
+	{ACL_GROUP, "group"},
+	{ACL_MASK, "mask"},
+	{ACL_OTHER, "other"},
+}
+
+// String returns a in the long text form printed by getfacl -n, with one
+// entry per line and numeric user and group IDs.
+func (a ACL) String() string {
+	var b strings.Builder
+	for _, e := range a {
+		for _, t := range aclTagNames {
+			if t.tag == e.Tag {
+				b.WriteString(t.name)
+				break
+			}
+		}
+		b.WriteByte(':')
+		if e.Tag == ACL_USER || e.Tag == ACL_GROUP {
+			b.WriteString(strconv.FormatUint(uint64(e.ID), 10))
+		}
+		b.WriteByte(':')
+		b.WriteString(aclPermString(e.Perm))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func aclPermString(perm uint16) string {
+	p := []byte("---")
+	if perm&ACL_READ != 0 {
+		p[0] = 'r'
+	}
+	if perm&ACL_WRITE != 0 {
+		p[1] = 'w'
+	}
+	if perm&ACL_EXECUTE != 0 {
+		p[2] = 'x'
+	}
+	return string(p)
+}
+
+// ParseACL parses an access ACL in the long or short text form accepted by
+// setfacl, such as "u::rw,u:1000:r,g::r,m::r,o::-" or the output of getfacl
+// for a file. Entries may be separated by commas or newlines and text
+// following a '#' is ignored. Users and groups must be given as numeric
+// IDs. The entries are not validated; use Valid to check the result.
+// ParseACL fails on default ACL entries; use ParseACLs for those.
+func ParseACL(text string) (ACL, error) {
+	acl, def, err := ParseACLs(text)
+	if err != nil {
+		return nil, err
+	}
+	if def != nil {
+		return nil, EINVAL
+	}
+	return acl, nil
+}
+
+// ParseACLs is like ParseACL but also accepts default ACL entries, which
+// are prefixed with "default:" or "d:", as in the output of getfacl for a
+// directory. It returns the access and default ACLs separately.
+func ParseACLs(text string) (access, def ACL, err error) {
+	for line := range strings.Lines(text) {
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		for f := range strings.SplitSeq(line, ",") {
+			f = strings.TrimSpace(f)
+			if f == "" {
+				continue
+			}
+			acl := &access
+			if rest, ok := strings.CutPrefix(f, "default:"); ok {
+				f, acl = rest, &def
+			} else if rest, ok := strings.CutPrefix(f, "d:"); ok {
+				f, acl = rest, &def
+			}
+			e, err := parseACLEntry(f)
+			if err != nil {
+				return nil, nil, err
+			}
+			*acl = append(*acl, e)
+		}
+	}
+	return access, def, nil
+}
+
+func parseACLEntry(s string) (ACLEntry, error) {
+	e := ACLEntry{ID: aclUndefinedID}
+	tag, rest, ok := strings.Cut(s, ":")
+	if !ok {
+		return e, EINVAL
+	}
+	qualifier, perm, ok := strings.Cut(rest, ":")
+	if !ok {
+		// "other" and "mask" entries may omit the empty qualifier.
+		qualifier, perm = "", rest
+	}
+	switch tag {
+	case "u", "user":
+		e.Tag = ACL_USER_OBJ
+	case "g", "group":
+		e.Tag = ACL_GROUP_OBJ
+	case "m", "mask":
+		e.Tag = ACL_MASK
+	case "o", "other":
+		e.Tag = ACL_OTHER
+	default:
+		return e, EINVAL
+	}
+	if qualifier != "" {
+		switch e.Tag {
+		case ACL_USER_OBJ:
+			e.Tag = ACL_USER
+		case ACL_GROUP_OBJ:
+			e.Tag = ACL_GROUP
+		default:
+			return e, EINVAL
+		}
+		id, err := strconv.ParseUint(qualifier, 10, 32)
+		if err != nil || uint32(id) == aclUndefinedID {
+			return e, EINVAL
+		}
+		e.ID = uint32(id)
+	}
+	for _, c := range strings.TrimSpace(perm) {
+		switch c {
+		case 'r':
+			e.Perm |= ACL_READ
+		case 'w':
+			e.Perm |= ACL_WRITE
+		case 'x':
+			e.Perm |= ACL_EXECUTE
+		case '-':
+		default:
+			return e, EINVAL
+		}
+	}
+	return e, nil
+}
+
+// GetACL returns the ACL stored in the extended attribute attr of the file
+// at path. attr is XATTR_NAME_POSIX_ACL_ACCESS or
+// XATTR_NAME_POSIX_ACL_DEFAULT.
+func GetACL(path string, attr string) (ACL, error) {
+	b, err := GetxattrBytes(path, attr)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeACL(b)
+}
+
+// FgetACL is like GetACL but operates on the open file fd.
+func FgetACL(fd int, attr string) (ACL, error) {
+	b, err := FgetxattrBytes(fd, attr)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeACL(b)
+}
+
+// SetACL stores acl in the extended attribute attr of the file at path.
+// attr is XATTR_NAME_POSIX_ACL_ACCESS or XATTR_NAME_POSIX_ACL_DEFAULT.
+func SetACL(path string, attr string, acl ACL) error {
+	return Setxattr(path, attr, acl.Encode(), 0)
+}
+
+// FsetACL is like SetACL but operates on the open file fd.
+func FsetACL(fd int, attr string, acl ACL) error {
+	return Fsetxattr(fd, attr, acl.Encode(), 0)
+}
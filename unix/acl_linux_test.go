@@ -0,0 +1,150 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix_test
+
+import (
+	"bytes"
+	"os"
+	"slices"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestACLEncoding(t *testing.T) {
+	// Output of "getfattr -e hex -n system.posix_acl_access" after
+	// "setfacl -m u:1000:rw,g:100:r" on a file with mode 0640.
+	raw := []byte{
+		0x02, 0x00, 0x00, 0x00,
+		0x01, 0x00, 0x06, 0x00, 0xff, 0xff, 0xff, 0xff,
+		0x02, 0x00, 0x06, 0x00, 0xe8, 0x03, 0x00, 0x00,
+		0x04, 0x00, 0x04, 0x00, 0xff, 0xff, 0xff, 0xff,
+		0x08, 0x00, 0x04, 0x00, 0x64, 0x00, 0x00, 0x00,
+		0x10, 0x00, 0x06, 0x00, 0xff, 0xff, 0xff, 0xff,
+		0x20, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff,
+	}
+	const text = "user::rw-\nuser:1000:rw-\ngroup::r--\ngroup:100:r--\nmask::rw-\nother::---\n"
+
+	acl, err := unix.DecodeACL(raw)
+	if err != nil {
+		t.Fatalf("DecodeACL: %v", err)
+	}
+	if err := acl.Valid(); err != nil {
+		t.Fatalf("Valid: %v", err)
+	}
+	if got := acl.String(); got != text {
+		t.Errorf("String: got %q, want %q", got, text)
+	}
+	if got := acl.Encode(); !bytes.Equal(got, raw) {
+		t.Errorf("Encode: got %x, want %x", got, raw)
+	}
+
+	parsed, err := unix.ParseACL("# file: f\n" + text)
+	if err != nil {
+		t.Fatalf("ParseACL: %v", err)
+	}
+	if got := parsed.Encode(); !bytes.Equal(got, raw) {
+		t.Errorf("ParseACL: got %x, want %x", got, raw)
+	}
+
+	// Short form, out of order.
+	parsed, err = unix.ParseACL("o::-,m::rw,g:100:r,g::r,u:1000:rw,u::rw")
+	if err != nil {
+		t.Fatalf("ParseACL: %v", err)
+	}
+	if got := parsed.Encode(); !bytes.Equal(got, raw) {
+		t.Errorf("ParseACL: got %x, want %x", got, raw)
+	}
+
+	// Output of getfacl for a directory.
+	access, def, err := unix.ParseACLs(text + "default:user::rwx\ndefault:group::r-x\nd:o::-\n")
+	if err != nil {
+		t.Fatalf("ParseACLs: %v", err)
+	}
+	if got := access.Encode(); !bytes.Equal(got, raw) {
+		t.Errorf("ParseACLs: got access %x, want %x", got, raw)
+	}
+	if got, want := def.String(), "user::rwx\ngroup::r-x\nother::---\n"; got != want {
+		t.Errorf("ParseACLs: got default %q, want %q", got, want)
+	}
+	if _, err := unix.ParseACL(text + "default:user::rwx\n"); err == nil {
+		t.Errorf("ParseACL: expected error on default entry")
+	}
+
+	for _, s := range []string{"user", "x::r", "user::rwz", "mask:1:r", "user:bob:r"} {
+		if _, err := unix.ParseACL(s); err == nil {
+			t.Errorf("ParseACL(%q): expected error", s)
+		}
+	}
+	if _, err := unix.DecodeACL(raw[:len(raw)-1]); err == nil {
+		t.Errorf("DecodeACL: expected error on truncated input")
+	}
+}
+
+func TestACLMode(t *testing.T) {
+	acl := unix.ACLFromMode(0754)
+	if !acl.IsMinimal() || acl.Mode() != 0754 {
+		t.Fatalf("ACLFromMode(0754): got %v", acl)
+	}
+
+	acl = append(acl, unix.ACLEntry{Tag: unix.ACL_USER, Perm: unix.ACL_READ | unix.ACL_WRITE, ID: 1000})
+	if err := acl.Valid(); err == nil {
+		t.Errorf("Valid: expected error for ACL without mask")
+	}
+	acl.CalcMask()
+	if err := acl.Valid(); err != nil {
+		t.Fatalf("Valid: %v", err)
+	}
+	if got := acl.Mode(); got != 0774 {
+		t.Errorf("Mode: got %#o, want %#o", got, 0774)
+	}
+
+	acl.SetMode(0740)
+	if got := acl.Mode(); got != 0740 {
+		t.Errorf("Mode after SetMode: got %#o, want %#o", got, 0740)
+	}
+	i := slices.IndexFunc(acl, func(e unix.ACLEntry) bool { return e.Tag == unix.ACL_GROUP_OBJ })
+	if acl[i].Perm != unix.ACL_READ|unix.ACL_EXECUTE {
+		t.Errorf("SetMode changed the ACL_GROUP_OBJ entry when a mask is present")
+	}
+}
+
+func TestGetSetACL(t *testing.T) {
+	chtmpdir(t)
+	f := "acl1"
+	touch(t, f)
+	if err := os.Chmod(f, 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	acl, err := unix.ParseACL("u::rw,u:1000:r,g::r,m::r,o::-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = unix.SetACL(f, unix.XATTR_NAME_POSIX_ACL_ACCESS, acl)
+	if err == unix.ENOTSUP || err == unix.EOPNOTSUPP {
+		t.Skip("filesystem does not support POSIX ACLs, skipping test")
+	} else if err != nil {
+		t.Fatalf("SetACL: %v", err)
+	}
+
+	got, err := unix.GetACL(f, unix.XATTR_NAME_POSIX_ACL_ACCESS)
+	if err != nil {
+		t.Fatalf("GetACL: %v", err)
+	}
+	if !bytes.Equal(got.Encode(), acl.Encode()) {
+		t.Errorf("GetACL: got %v, want %v", got, acl)
+	}
+
+	var st unix.Stat_t
+	if err := unix.Stat(f, &st); err != nil {
+		t.Fatal(err)
+	}
+	if mode := st.Mode & 0777; mode != acl.Mode() {
+		t.Errorf("file mode %#o does not match ACL mode %#o", mode, acl.Mode())
+	}
+}
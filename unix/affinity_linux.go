@@ -187,3 +187,101 @@ func SchedGetaffinityDynamic(pid int, set CPUSetDynamic) error {
 func SchedSetaffinityDynamic(pid int, set CPUSetDynamic) error {
 	return schedAffinity(SYS_SCHED_SETAFFINITY, pid, set.size(), set.pointer())
 }
+
+// NodeSet represents a bit mask of NUMA nodes, to be used with [GetMemPolicy],
+// [Mbind] and [MigratePages].
+//
+// Note this type can only represent node IDs 0 through 1023.
+// Use [NodeSetDynamic]/[NewNodeSet] instead to avoid this limit.
+type NodeSet [cpuSetSize]cpuMask
+
+// NodeSetDynamic represents a bit mask of NUMA nodes, to be used with
+// [GetMemPolicyDynamic], [MbindDynamic] and [MigratePagesDynamic]. Use
+// [NewNodeSet] to allocate. The kernel handles node masks in whole 64-bit
+// words, so those functions return [EINVAL] for sets of an odd length on
+// 32-bit architectures.
+type NodeSetDynamic []cpuMask
+
+// Zero clears the set s, so that it contains no nodes.
+func (s *NodeSet) Zero() {
+	clear(s[:])
+}
+
+// Set adds node to the set s. If node is out of bounds for s, no action is taken.
+func (s *NodeSet) Set(node int) {
+	cpuMaskSet(s[:], node)
+}
+
+// Clear removes node from the set s. If node is out of bounds for s, no action is taken.
+func (s *NodeSet) Clear(node int) {
+	cpuMaskClear(s[:], node)
+}
+
+// IsSet reports whether node is in the set s.
+func (s *NodeSet) IsSet(node int) bool {
+	return cpuMaskIsSet(s[:], node)
+}
+
+// Count returns the number of nodes in the set s.
+func (s *NodeSet) Count() int {
+	return cpuMaskCount(s[:])
+}
+
+// maxnode returns the maxnode argument describing s to the memory policy
+// system calls. The kernel only looks at the first maxnode-1 bits.
+func (s *NodeSet) maxnode() uintptr {
+	return uintptr(len(s))*_NCPUBITS + 1
+}
+
+// NewNodeSet creates a NUMA node mask capable of representing node IDs
+// up to maxNode (exclusive).
+func NewNodeSet(maxNode int) NodeSetDynamic {
+	// Round up to whole 64-bit words; see NodeSetDynamic.
+	return NodeSetDynamic(NewCPUSet((max(maxNode, 1) + 63) &^ 63))
+}
+
+// Zero clears the set s, so that it contains no nodes.
+func (s NodeSetDynamic) Zero() {
+	clear(s)
+}
+
+// Set adds node to the set s. If node is out of bounds for s, no action is taken.
+func (s NodeSetDynamic) Set(node int) {
+	cpuMaskSet(s, node)
+}
+
+// Clear removes node from the set s. If node is out of bounds for s, no action is taken.
+func (s NodeSetDynamic) Clear(node int) {
+	cpuMaskClear(s, node)
+}
+
+// IsSet reports whether node is in the set s.
+func (s NodeSetDynamic) IsSet(node int) bool {
+	return cpuMaskIsSet(s, node)
+}
+
+// Count returns the number of nodes in the set s.
+func (s NodeSetDynamic) Count() int {
+	return cpuMaskCount(s)
+}
+
+// maxnode is like NodeSet.maxnode, but fails with EINVAL if s does not
+// hold a whole number of 64-bit words: get_mempolicy(2) writes whole
+// words, and the kernel would ignore the last 32 bits otherwise.
+func (s NodeSetDynamic) maxnode() (uintptr, error) {
+	if len(s) == 0 {
+		return 0, nil
+	}
+	bits := uintptr(len(s)) * _NCPUBITS
+	if bits%64 != 0 {
+		return 0, EINVAL
+	}
+	return bits + 1, nil
+}
+
+func (s NodeSetDynamic) pointer() unsafe.Pointer {
+	if len(s) == 0 {
+		return nil
+	}
+	return unsafe.Pointer(&s[0])
+}
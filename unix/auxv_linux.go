@@ -0,0 +1,254 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix
+
+import (
+	"strconv"
+	"unsafe"
+)
+
+// AuxvKey is the key of an entry of the ELF auxiliary vector, one of the
+// AT_* constants such as AT_PAGESZ.
+type AuxvKey uintptr
+
+var auxvKeyNames = map[AuxvKey]string{
+	AT_NULL:              "AT_NULL",
+	AT_IGNORE:            "AT_IGNORE",
+	AT_EXECFD:            "AT_EXECFD",
+	AT_PHDR:              "AT_PHDR",
+	AT_PHENT:             "AT_PHENT",
+	AT_PHNUM:             "AT_PHNUM",
+	AT_PAGESZ:            "AT_PAGESZ",
+	AT_BASE:              "AT_BASE",
+	AT_FLAGS:             "AT_FLAGS",
+	AT_ENTRY:             "AT_ENTRY",
+	AT_NOTELF:            "AT_NOTELF",
+	AT_UID:               "AT_UID",
+	AT_EUID:              "AT_EUID",
+	AT_GID:               "AT_GID",
+	AT_EGID:              "AT_EGID",
+	AT_PLATFORM:          "AT_PLATFORM",
+	AT_HWCAP:             "AT_HWCAP",
+	AT_CLKTCK:            "AT_CLKTCK",
+	AT_SECURE:            "AT_SECURE",
+	AT_BASE_PLATFORM:     "AT_BASE_PLATFORM",
+	AT_RANDOM:            "AT_RANDOM",
+	AT_HWCAP2:            "AT_HWCAP2",
+	AT_RSEQ_FEATURE_SIZE: "AT_RSEQ_FEATURE_SIZE",
+	AT_RSEQ_ALIGN:        "AT_RSEQ_ALIGN",
+	AT_HWCAP3:            "AT_HWCAP3",
+	AT_HWCAP4:            "AT_HWCAP4",
+	AT_EXECFN:            "AT_EXECFN",
+	AT_SYSINFO_EHDR:      "AT_SYSINFO_EHDR",
+	AT_MINSIGSTKSZ:       "AT_MINSIGSTKSZ",
+}
+
+// String returns the name of the constant for k, such as "AT_PAGESZ", or
+// "AT_" followed by the number of k if it is not known.
+func (k AuxvKey) String() string {
+	if name, ok := auxvKeyNames[k]; ok {
+		return name
+	}
+	return "AT_" + strconv.FormatUint(uint64(k), 10)
+}
+
+// AuxvEntry is an entry of the ELF auxiliary vector.
+type AuxvEntry struct {
+	Key   AuxvKey
+	Value uintptr
+}
+
+// AuxVector is the ELF auxiliary vector passed by the kernel to a process
+// when it starts. Some entries hold the addresses of strings or bytes in
+// the memory of that process, which the accessors read.
+type AuxVector struct {
+	Entries []AuxvEntry // Entries, without the terminating AT_NULL one
+	pid     int         // 0 for the current process
+}
+
+// CurrentAuxVector returns the auxiliary vector of the current process.
+func CurrentAuxVector() (*AuxVector, error) {
+	vec, err := Auxv()
+	if err != nil {
+		return nil, err
+	}
+	v := new(AuxVector)
+	for _, kv := range vec {
+		if kv[0] == AT_NULL {
+			break
+		}
+		v.Entries = append(v.Entries, AuxvEntry{AuxvKey(kv[0]), kv[1]})
+	}
+	return v, nil
+}
+
+// ReadAuxVector reads the auxiliary vector of the process pid from
+// /proc/<pid>/auxv. The process must have the same word size as the
+// caller. Reading the strings and bytes it points to goes through
+// /proc/<pid>/mem, which requires the permission to trace the process.
+func ReadAuxVector(pid int) (*AuxVector, error) {
+	fd, err := Open("/proc/"+strconv.Itoa(pid)+"/auxv", O_RDONLY|O_CLOEXEC, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer Close(fd)
+
+	var b []byte
+	buf := make([]byte, 1024)
+	for {
+		n, err := Read(fd, buf)
+		if err == EINTR {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			break
+		}
+		b = append(b, buf[:n]...)
+	}
+
+	const wordSize = int(unsafe.Sizeof(uintptr(0)))
+	if len(b)%(2*wordSize) != 0 {
+		return nil, EINVAL
+	}
+	v := &AuxVector{pid: pid}
+	for len(b) > 0 {
+		var kv [2]uintptr
+		copy(unsafe.Slice((*byte)(unsafe.Pointer(&kv)), 2*wordSize), b)
+		b = b[2*wordSize:]
+		if kv[0] == AT_NULL {
+			break
+		}
+		v.Entries = append(v.Entries, AuxvEntry{AuxvKey(kv[0]), kv[1]})
+	}
+	return v, nil
+}
+
+// Lookup returns the value of the first entry with the given key and
+// whether there is one.
+func (v *AuxVector) Lookup(key AuxvKey) (uintptr, bool) {
+	for _, e := range v.Entries {
+		if e.Key == key {
+			return e.Value, true
+		}
+	}
+	return 0, false
+}
+
+func (v *AuxVector) value(key AuxvKey) uintptr {
+	val, _ := v.Lookup(key)
+	return val
+}
+
+// HWCAP returns the AT_HWCAP bit mask of processor features, or 0.
+func (v *AuxVector) HWCAP() uintptr { return v.value(AT_HWCAP) }
+
+// HWCAP2 returns the AT_HWCAP2 bit mask of processor features, or 0.
+func (v *AuxVector) HWCAP2() uintptr { return v.value(AT_HWCAP2) }
+
+// HWCAP3 returns the AT_HWCAP3 bit mask of processor features, or 0.
+func (v *AuxVector) HWCAP3() uintptr { return v.value(AT_HWCAP3) }
+
+// HWCAP4 returns the AT_HWCAP4 bit mask of processor features, or 0.
+func (v *AuxVector) HWCAP4() uintptr { return v.value(AT_HWCAP4) }
+
+// PageSize returns the system page size, or 0.
+func (v *AuxVector) PageSize() int { return int(v.value(AT_PAGESZ)) }
+
+// ClockTick returns the frequency of times(2) in ticks per second, or 0.
+func (v *AuxVector) ClockTick() int { return int(v.value(AT_CLKTCK)) }
+
+// MinSigStackSize returns the minimal size of a signal stack, or 0 on
+// architectures or kernels that do not report it.
+func (v *AuxVector) MinSigStackSize() int { return int(v.value(AT_MINSIGSTKSZ)) }
+
+// SysinfoEHDR returns the address of the ELF header of the vDSO, or 0.
+func (v *AuxVector) SysinfoEHDR() uintptr { return v.value(AT_SYSINFO_EHDR) }
+
+// Random returns the 16 random bytes the kernel provides to seed the
+// process's random number generators.
+func (v *AuxVector) Random() ([]byte, error) {
+	addr, ok := v.Lookup(AT_RANDOM)
+	if !ok {
+		return nil, ENOENT
+	}
+	b := make([]byte, 16)
+	if err := v.readMem(addr, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// ExecFn returns the path name used to execute the program.
+func (v *AuxVector) ExecFn() (string, error) { return v.stringAt(AT_EXECFN) }
+
+// Platform returns the name of the hardware platform, such as "x86_64".
+func (v *AuxVector) Platform() (string, error) { return v.stringAt(AT_PLATFORM) }
+
+// BasePlatform returns the name of the base hardware platform, which only
+// some architectures report.
+func (v *AuxVector) BasePlatform() (string, error) { return v.stringAt(AT_BASE_PLATFORM) }
+
+// stringAt returns the NUL-terminated string at the address held by the
+// entry with the given key.
+func (v *AuxVector) stringAt(key AuxvKey) (string, error) {
+	addr, ok := v.Lookup(key)
+	if !ok || addr == 0 {
+		return "", ENOENT
+	}
+	if v.pid == 0 {
+		return BytePtrToString((*byte)(unsafe.Pointer(addr))), nil
+	}
+
+	// Read in aligned chunks so as not to cross the end of the mapping,
+	// which is likely near for strings at the top of the stack.
+	const chunkSize = 64
+	var s []byte
+	buf := make([]byte, chunkSize)
+	for len(s) < PathMax {
+		a := addr + uintptr(len(s))
+		chunk := buf[:chunkSize-a%chunkSize]
+		if err := v.readMem(a, chunk); err != nil {
+			return "", err
+		}
+		for i, c := range chunk {
+			if c == 0 {
+				return string(append(s, chunk[:i]...)), nil
+			}
+		}
+		s = append(s, chunk...)
+	}
+	return "", ENAMETOOLONG
+}
+
+// readMem fills b with the memory of the process at addr.
+func (v *AuxVector) readMem(addr uintptr, b []byte) error {
+	if v.pid == 0 {
+		copy(b, unsafe.Slice((*byte)(unsafe.Pointer(addr)), len(b)))
+		return nil
+	}
+	fd, err := Open("/proc/"+strconv.Itoa(v.pid)+"/mem", O_RDONLY|O_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	defer Close(fd)
+	for len(b) > 0 {
+		n, err := Pread(fd, b, int64(addr))
+		if err == EINTR {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return EIO
+		}
+		b = b[n:]
+		addr += uintptr(n)
+	}
+	return nil
+}
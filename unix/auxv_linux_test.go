@@ -7,6 +7,9 @@
 package unix_test
 
 import (
+	"bytes"
+	"os"
+	"slices"
 	"testing"
 
 	"golang.org/x/sys/unix"
@@ -21,3 +24,52 @@ func TestAuxv(t *testing.T) {
 		t.Errorf("got zero auxv entries on linux, expected > 0")
 	}
 }
+
+func TestAuxVector(t *testing.T) {
+	if got := unix.AuxvKey(unix.AT_PAGESZ).String(); got != "AT_PAGESZ" {
+		t.Errorf("AuxvKey(AT_PAGESZ).String() = %q", got)
+	}
+	if got := unix.AuxvKey(1000).String(); got != "AT_1000" {
+		t.Errorf("AuxvKey(1000).String() = %q", got)
+	}
+
+	self, err := unix.CurrentAuxVector()
+	if err != nil {
+		t.Fatalf("CurrentAuxVector: %v", err)
+	}
+	if got, want := self.PageSize(), os.Getpagesize(); got != want {
+		t.Errorf("PageSize() = %d, want %d", got, want)
+	}
+	if self.ClockTick() <= 0 {
+		t.Errorf("ClockTick() = %d", self.ClockTick())
+	}
+	execFn, err := self.ExecFn()
+	if err != nil || execFn == "" {
+		t.Errorf("ExecFn() = %q, %v", execFn, err)
+	}
+	random, err := self.Random()
+	if err != nil || len(random) != 16 {
+		t.Errorf("Random() = %x, %v", random, err)
+	}
+
+	proc, err := unix.ReadAuxVector(os.Getpid())
+	if err != nil {
+		t.Fatalf("ReadAuxVector: %v", err)
+	}
+	if !slices.Equal(proc.Entries, self.Entries) {
+		t.Errorf("ReadAuxVector entries differ from CurrentAuxVector:\n%v\n%v", proc.Entries, self.Entries)
+	}
+	if got, err := proc.ExecFn(); err != nil || got != execFn {
+		t.Errorf("ReadAuxVector: ExecFn() = %q, %v; want %q", got, err, execFn)
+	}
+	if got, err := proc.Random(); err != nil || !bytes.Equal(got, random) {
+		t.Errorf("ReadAuxVector: Random() = %x, %v; want %x", got, err, random)
+	}
+	platform, err := self.Platform()
+	if got, err2 := proc.Platform(); got != platform || (err == nil) != (err2 == nil) {
+		t.Errorf("ReadAuxVector: Platform() = %q, %v; want %q, %v", got, err2, platform, err)
+	}
+	if _, ok := self.Lookup(1000); ok {
+		t.Errorf("Lookup(1000) found an entry")
+	}
+}
@@ -92,6 +92,10 @@ var darwinTests = [...]darwinTest{
 	{"mmap", libc_mmap_trampoline_addr},
 	{"mount", libc_mount_trampoline_addr},
 	{"mprotect", libc_mprotect_trampoline_addr},
+	{"msgctl", libc_msgctl_trampoline_addr},
+	{"msgget", libc_msgget_trampoline_addr},
+	{"msgrcv", libc_msgrcv_trampoline_addr},
+	{"msgsnd", libc_msgsnd_trampoline_addr},
 	{"msync", libc_msync_trampoline_addr},
 	{"munlock", libc_munlock_trampoline_addr},
 	{"munlockall", libc_munlockall_trampoline_addr},
@@ -123,6 +127,9 @@ var darwinTests = [...]darwinTest{
 	{"revoke", libc_revoke_trampoline_addr},
 	{"rmdir", libc_rmdir_trampoline_addr},
 	{"select", libc_select_trampoline_addr},
+	{"semctl", libc_semctl_trampoline_addr},
+	{"semget", libc_semget_trampoline_addr},
+	{"semop", libc_semop_trampoline_addr},
 	{"sendfile", libc_sendfile_trampoline_addr},
 	{"sendmsg", libc_sendmsg_trampoline_addr},
 	{"sendto", libc_sendto_trampoline_addr},
@@ -92,6 +92,10 @@ var darwinTests = [...]darwinTest{
 	{"mmap", libc_mmap_trampoline_addr},
 	{"mount", libc_mount_trampoline_addr},
 	{"mprotect", libc_mprotect_trampoline_addr},
+	{"msgctl", libc_msgctl_trampoline_addr},
+	{"msgget", libc_msgget_trampoline_addr},
+	{"msgrcv", libc_msgrcv_trampoline_addr},
+	{"msgsnd", libc_msgsnd_trampoline_addr},
 	{"msync", libc_msync_trampoline_addr},
 	{"munlock", libc_munlock_trampoline_addr},
 	{"munlockall", libc_munlockall_trampoline_addr},
@@ -107,6 +111,7 @@ var darwinTests = [...]darwinTest{
 	{"pthread_fchdir_np", libc_pthread_fchdir_np_trampoline_addr},
 	{"ptrace", libc_ptrace_trampoline_addr},
 	{"pwrite", libc_pwrite_trampoline_addr},
+	{"pwritev", libc_pwritev_trampoline_addr},
 	{"read", libc_read_trampoline_addr},
 	{"readdir_r", libc_readdir_r_trampoline_addr},
 	{"readlink", libc_readlink_trampoline_addr},
@@ -122,6 +127,8 @@ var darwinTests = [...]darwinTest{
 	{"revoke", libc_revoke_trampoline_addr},
 	{"rmdir", libc_rmdir_trampoline_addr},
 	{"select", libc_select_trampoline_addr},
+	{"semget", libc_semget_trampoline_addr},
+	{"semop", libc_semop_trampoline_addr},
 	{"sendfile", libc_sendfile_trampoline_addr},
 	{"sendmsg", libc_sendmsg_trampoline_addr},
 	{"sendto", libc_sendto_trampoline_addr},
@@ -100,3 +100,137 @@ func ParseDirent(buf []byte, max int, names []string) (consumed int, count int,
 	}
 	return origlen - len(buf), count, names
 }
+
+// DirEntry is a directory entry returned by a DirentReader.
+type DirEntry struct {
+	Name string
+	Ino  uint64
+
+	// Type is the file type, one of the DT_* constants, or 0
+	// (DT_UNKNOWN) if the file system or platform does not report it.
+	Type uint8
+
+	// Off is the directory offset following this entry, which can be
+	// passed to DirentReader.SeekTo to resume reading after it. It is 0 on
+	// platforms that do not report it (darwin, dragonfly, netbsd and zos).
+	Off int64
+}
+
+// DirentReader reads directory entries, including their inode numbers and
+// file types, from an open directory using ReadDirent. Like ParseDirent it
+// skips the "." and ".." entries. A typical use is:
+//
+//	r := NewDirentReader(fd, 0)
+//	for r.Next() {
+//		e := r.Entry()
+//		...
+//	}
+//	if err := r.Err(); err != nil {
+//		...
+//	}
+type DirentReader struct {
+	fd    int
+	buf   []byte
+	rec   []byte // unparsed records in buf
+	entry DirEntry
+	err   error
+}
+
+// NewDirentReader returns a DirentReader that reads from the directory fd
+// using a buffer of bufsize bytes. A default size is used if bufsize is 0.
+// The buffer is reused for the lifetime of the reader.
+func NewDirentReader(fd int, bufsize int) *DirentReader {
+	if bufsize <= 0 {
+		bufsize = 8192
+	}
+	bufsize = max(bufsize, int(unsafe.Sizeof(Dirent{})))
+	return &DirentReader{fd: fd, buf: make([]byte, bufsize)}
+}
+
+// Next advances to the next entry, which is then available through Entry.
+// It returns false at the end of the directory or on error; Err reports
+// which.
+func (r *DirentReader) Next() bool {
+	for r.err == nil {
+		for len(r.rec) > 0 {
+			reclen, ok := direntReclen(r.rec)
+			if !ok || reclen == 0 || reclen > uint64(len(r.rec)) {
+				r.rec = nil
+				break
+			}
+			rec := r.rec[:reclen]
+			r.rec = r.rec[reclen:]
+			if r.parse(rec) {
+				return true
+			}
+		}
+		n, err := ReadDirent(r.fd, r.buf)
+		if err != nil {
+			r.err = err
+			return false
+		}
+		if n <= 0 {
+			return false
+		}
+		r.rec = r.buf[:n]
+	}
+	return false
+}
+
+// parse decodes rec into r.entry and reports whether it holds an entry
+// that should be returned.
+func (r *DirentReader) parse(rec []byte) bool {
+	ino, ok := direntIno(rec)
+	if !ok || ino == 0 {
+		return false
+	}
+	const namoff = uint64(unsafe.Offsetof(Dirent{}.Name))
+	namlen, ok := direntNamlen(rec)
+	if !ok || namoff+namlen > uint64(len(rec)) {
+		return false
+	}
+	name := rec[namoff : namoff+namlen]
+	for i, c := range name {
+		if c == 0 {
+			name = name[:i]
+			break
+		}
+	}
+	if string(name) == "." || string(name) == ".." {
+		return false
+	}
+	typ, _ := direntType(rec)
+	off, _ := direntOff(rec)
+	r.entry = DirEntry{
+		Name: string(name),
+		Ino:  ino,
+		Type: uint8(typ),
+		Off:  int64(off),
+	}
+	return true
+}
+
+// Entry returns the entry read by the most recent call to Next.
+func (r *DirentReader) Entry() DirEntry {
+	return r.entry
+}
+
+// Err returns the first error encountered by Next.
+func (r *DirentReader) Err() error {
+	return r.err
+}
+
+// SeekTo positions the reader at the directory offset off, which is 0 or
+// the Off field of a previously returned entry, discarding any buffered
+// entries and clearing the error state.
+func (r *DirentReader) SeekTo(off int64) error {
+	r.rec = nil
+	r.err = nil
+	_, err := Seek(r.fd, off, 0 /* SEEK_SET */)
+	return err
+}
+
+// Reset positions the reader at the start of the directory.
+func (r *DirentReader) Reset() error {
+	return r.SeekTo(0)
+}
@@ -151,3 +151,89 @@ func TestDirentRepeat(t *testing.T) {
 		t.Errorf("bad file list: want\n%q\ngot\n%q", files, files2)
 	}
 }
+
+func TestDirentReader(t *testing.T) {
+	// DT_DIR and DT_REG have the same values on all platforms that report
+	// d_type, but are not defined everywhere.
+	const (
+		dtDir = 4
+		dtReg = 8
+	)
+
+	d := t.TempDir()
+	want := make(map[string]uint8)
+	for i := range 50 {
+		name := fmt.Sprintf("file%02d", i)
+		if err := os.WriteFile(filepath.Join(d, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+		want[name] = dtReg
+	}
+	if err := os.Mkdir(filepath.Join(d, "dir"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	want["dir"] = dtDir
+
+	fd, err := unix.Open(d, unix.O_RDONLY|unix.O_DIRECTORY, 0)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer unix.Close(fd)
+
+	// Use a small buffer to make the reader refill it several times.
+	r := unix.NewDirentReader(fd, 1)
+	var entries []unix.DirEntry
+	for r.Next() {
+		entries = append(entries, r.Entry())
+	}
+	if err := r.Err(); err != nil {
+		t.Fatalf("Next: %v", err)
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("got %d entries, want %d", len(entries), len(want))
+	}
+	for _, e := range entries {
+		typ, ok := want[e.Name]
+		if !ok {
+			t.Errorf("unexpected entry %q", e.Name)
+			continue
+		}
+		if e.Type != 0 && e.Type != typ {
+			t.Errorf("%s: got type %d, want %d", e.Name, e.Type, typ)
+		}
+		var st unix.Stat_t
+		if err := unix.Lstat(filepath.Join(d, e.Name), &st); err != nil {
+			t.Fatal(err)
+		}
+		if e.Ino != uint64(st.Ino) {
+			t.Errorf("%s: got inode %d, want %d", e.Name, e.Ino, st.Ino)
+		}
+	}
+
+	if err := r.Reset(); err != nil {
+		t.Fatalf("Reset: %v", err)
+	}
+	n := 0
+	for r.Next() {
+		n++
+	}
+	if err := r.Err(); err != nil {
+		t.Fatalf("Next after Reset: %v", err)
+	}
+	if n != len(want) {
+		t.Errorf("got %d entries after Reset, want %d", n, len(want))
+	}
+
+	if off := entries[len(entries)/2].Off; off != 0 {
+		if err := r.SeekTo(off); err != nil {
+			t.Fatalf("SeekTo: %v", err)
+		}
+		n = 0
+		for r.Next() {
+			n++
+		}
+		if want := len(entries) - len(entries)/2 - 1; n != want {
+			t.Errorf("got %d entries after SeekTo, want %d", n, want)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix
+
+import (
+	"crypto/hkdf"
+	"crypto/sha512"
+	"unsafe"
+)
+
+// fscryptHKDFContextKeyIdentifier is the HKDF context byte the kernel uses
+// when deriving a key identifier from a v2 master key. See
+// HKDF_CONTEXT_KEY_IDENTIFIER in fs/crypto/fscrypt_private.h.
+const fscryptHKDFContextKeyIdentifier = 1
+
+// FscryptKeyIdentifier derives the identifier the kernel assigns to the v2
+// master key rawKey when it is added with FS_IOC_ADD_ENCRYPTION_KEY. The
+// identifier is computed with HKDF-SHA512 exactly as the kernel does, so it
+// can be used in a FscryptPolicyV2 before the key is added.
+func FscryptKeyIdentifier(rawKey []byte) (id [FSCRYPT_KEY_IDENTIFIER_SIZE]byte, err error) {
+	if len(rawKey) == 0 || len(rawKey) > FSCRYPT_MAX_KEY_SIZE {
+		return id, EINVAL
+	}
+	info := "fscrypt\x00" + string(rune(fscryptHKDFContextKeyIdentifier))
+	b, err := hkdf.Key(sha512.New, rawKey, nil, info, len(id))
+	if err != nil {
+		return id, err
+	}
+	copy(id[:], b)
+	return id, nil
+}
+
+// SetDescriptor makes k refer to a v1 master key by its 8-byte descriptor.
+func (k *FscryptKeySpecifier) SetDescriptor(desc [FSCRYPT_KEY_DESCRIPTOR_SIZE]byte) {
+	*k = FscryptKeySpecifier{Type: FSCRYPT_KEY_SPEC_TYPE_DESCRIPTOR}
+	copy(k.U[:], desc[:])
+}
+
+// SetIdentifier makes k refer to a v2 master key by its 16-byte identifier.
+func (k *FscryptKeySpecifier) SetIdentifier(id [FSCRYPT_KEY_IDENTIFIER_SIZE]byte) {
+	*k = FscryptKeySpecifier{Type: FSCRYPT_KEY_SPEC_TYPE_IDENTIFIER}
+	copy(k.U[:], id[:])
+}
+
+// Descriptor returns the v1 key descriptor held in k.
+func (k *FscryptKeySpecifier) Descriptor() (desc [FSCRYPT_KEY_DESCRIPTOR_SIZE]byte) {
+	copy(desc[:], k.U[:])
+	return desc
+}
+
+// Identifier returns the v2 key identifier held in k.
+func (k *FscryptKeySpecifier) Identifier() (id [FSCRYPT_KEY_IDENTIFIER_SIZE]byte) {
+	copy(id[:], k.U[:])
+	return id
+}
+
+// V1 returns the policy held in a as a v1 policy, or nil if a holds a
+// policy of a different version.
+func (a *FscryptGetPolicyExArg) V1() *FscryptPolicyV1 {
+	if a.Size < uint64(unsafe.Sizeof(FscryptPolicyV1{})) || a.Policy[0] != FSCRYPT_POLICY_V1 {
+		return nil
+	}
+	return (*FscryptPolicyV1)(unsafe.Pointer(&a.Policy[0]))
+}
+
+// V2 returns the policy held in a as a v2 policy, or nil if a holds a
+// policy of a different version.
+func (a *FscryptGetPolicyExArg) V2() *FscryptPolicyV2 {
+	if a.Size < uint64(unsafe.Sizeof(FscryptPolicyV2{})) || a.Policy[0] != FSCRYPT_POLICY_V2 {
+		return nil
+	}
+	return (*FscryptPolicyV2)(unsafe.Pointer(&a.Policy[0]))
+}
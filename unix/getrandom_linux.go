@@ -0,0 +1,177 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix
+
+import "sync/atomic"
+
+// getrandomMax is the largest number of bytes getrandom(2) returns in one
+// call on all kernels, so that larger reads are split.
+const getrandomMax = 1<<25 - 1
+
+var (
+	getrandomUnsupported    atomic.Bool // getrandom(2) fails with ENOSYS, before Linux 3.17
+	grndInsecureUnsupported atomic.Bool // GRND_INSECURE fails with EINVAL, before Linux 5.6
+)
+
+// RandomReader is an io.Reader of random bytes from the kernel. It reads
+// with getrandom(2), through the vDSO when the kernel and Go runtime support
+// it, and falls back to the random devices on kernels without getrandom(2)
+// or GRND_INSECURE. It is safe for concurrent use.
+type RandomReader struct {
+	flags int
+}
+
+// NewRandomReader returns a RandomReader that reads with the given
+// getrandom(2) flags, a combination of GRND_NONBLOCK and either GRND_RANDOM
+// or GRND_INSECURE.
+//
+// Without GRND_INSECURE, reads block until the kernel entropy pool is
+// initialized, which may take a while early during boot. With
+// GRND_NONBLOCK, they fail with EAGAIN instead; see also RandomReady.
+func NewRandomReader(flags int) (*RandomReader, error) {
+	if flags&^(GRND_NONBLOCK|GRND_RANDOM|GRND_INSECURE) != 0 ||
+		flags&(GRND_RANDOM|GRND_INSECURE) == GRND_RANDOM|GRND_INSECURE {
+		return nil, EINVAL
+	}
+	return &RandomReader{flags: flags}, nil
+}
+
+// Read fills p with random bytes. Unlike getrandom(2), it only returns
+// fewer than len(p) bytes together with an error; reads interrupted by
+// signals are retried.
+func (r *RandomReader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		m, err := r.read(p[n:min(len(p), n+getrandomMax)])
+		n += m
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+func (r *RandomReader) read(p []byte) (int, error) {
+	if getrandomUnsupported.Load() || r.flags&GRND_INSECURE != 0 && grndInsecureUnsupported.Load() {
+		return readRandomDevice(p, r.flags)
+	}
+	for {
+		n, err := Getrandom(p, r.flags)
+		switch {
+		case err == nil && n == 0:
+			return 0, EIO
+		case err == nil:
+			return n, nil
+		case err == EINTR:
+			continue
+		case err == ENOSYS:
+			getrandomUnsupported.Store(true)
+			return readRandomDevice(p, r.flags)
+		case err == EINVAL && r.flags&GRND_INSECURE != 0:
+			grndInsecureUnsupported.Store(true)
+			return readRandomDevice(p, r.flags)
+		}
+		return 0, err
+	}
+}
+
+// RandomReady reports whether the kernel entropy pool is initialized, that
+// is, whether reads without GRND_INSECURE would not block. Programs that
+// may run early during boot can use it to decide whether to wait for
+// randomness or to make do with GRND_INSECURE.
+func RandomReady() (bool, error) {
+	for !getrandomUnsupported.Load() {
+		_, err := Getrandom(nil, GRND_NONBLOCK)
+		switch err {
+		case nil:
+			return true, nil
+		case EAGAIN:
+			return false, nil
+		case EINTR:
+			continue
+		case ENOSYS:
+			getrandomUnsupported.Store(true)
+		default:
+			return false, err
+		}
+	}
+	switch err := waitRandomDevice(true); err {
+	case nil:
+		return true, nil
+	case EAGAIN:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// readRandomDevice reads p from /dev/urandom, or /dev/random for
+// GRND_RANDOM. Unless flags has GRND_INSECURE, it first waits for the
+// entropy pool to be initialized like getrandom(2) does, which reads of
+// /dev/urandom do not.
+func readRandomDevice(p []byte, flags int) (int, error) {
+	nonblock := flags&GRND_NONBLOCK != 0
+	if flags&GRND_INSECURE == 0 {
+		if err := waitRandomDevice(nonblock); err != nil {
+			return 0, err
+		}
+	}
+	name, mode := "/dev/urandom", O_RDONLY|O_CLOEXEC
+	if flags&GRND_RANDOM != 0 {
+		name = "/dev/random"
+	}
+	if nonblock {
+		mode |= O_NONBLOCK
+	}
+	fd, err := Open(name, mode, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer Close(fd)
+	n := 0
+	for n < len(p) {
+		m, err := Read(fd, p[n:])
+		if err == EINTR {
+			continue
+		}
+		if err != nil {
+			return n, err
+		}
+		if m == 0 {
+			return n, EIO
+		}
+		n += m
+	}
+	return n, nil
+}
+
+// waitRandomDevice waits for the entropy pool to be initialized on kernels
+// without getrandom(2), by polling /dev/random, or returns EAGAIN if it is
+// not and nonblock is set.
+func waitRandomDevice(nonblock bool) error {
+	fd, err := Open("/dev/random", O_RDONLY|O_CLOEXEC|O_NONBLOCK, 0)
+	if err != nil {
+		return err
+	}
+	defer Close(fd)
+	timeout := -1
+	if nonblock {
+		timeout = 0
+	}
+	fds := []PollFd{{Fd: int32(fd), Events: POLLIN}}
+	for {
+		n, err := Poll(fds, timeout)
+		if err == EINTR {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return EAGAIN
+		}
+		return nil
+	}
+}
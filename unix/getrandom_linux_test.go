@@ -0,0 +1,50 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix_test
+
+import (
+	"bytes"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestRandomReader(t *testing.T) {
+	for _, flags := range []int{0, unix.GRND_NONBLOCK, unix.GRND_INSECURE, unix.GRND_RANDOM | unix.GRND_NONBLOCK} {
+		r, err := unix.NewRandomReader(flags)
+		if err != nil {
+			t.Fatalf("NewRandomReader(%#x): %v", flags, err)
+		}
+		b := make([]byte, 1<<16)
+		if flags&unix.GRND_RANDOM != 0 {
+			b = b[:64]
+		}
+		n, err := r.Read(b)
+		if err == unix.EAGAIN && flags&unix.GRND_NONBLOCK != 0 {
+			continue
+		}
+		if err != nil || n != len(b) {
+			t.Fatalf("flags %#x: Read = %d, %v; want %d, nil", flags, n, err, len(b))
+		}
+		if bytes.Equal(b[:32], make([]byte, 32)) {
+			t.Errorf("flags %#x: Read returned zeros", flags)
+		}
+	}
+
+	if _, err := unix.NewRandomReader(unix.GRND_RANDOM | unix.GRND_INSECURE); err != unix.EINVAL {
+		t.Errorf("NewRandomReader(GRND_RANDOM|GRND_INSECURE): got %v, want EINVAL", err)
+	}
+	if _, err := unix.NewRandomReader(0x100); err != unix.EINVAL {
+		t.Errorf("NewRandomReader(0x100): got %v, want EINVAL", err)
+	}
+}
+
+func TestRandomReady(t *testing.T) {
+	ready, err := unix.RandomReady()
+	if err != nil {
+		t.Fatalf("RandomReady: %v", err)
+	}
+	t.Logf("RandomReady: %v", ready)
+}
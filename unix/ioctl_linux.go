@@ -243,6 +243,79 @@ func IoctlFileDedupeRange(srcFd int, value *FileDedupeRange) error {
 	return err
 }
 
+// IoctlFiemap maps the extents of the file associated with fd using the
+// FS_IOC_FIEMAP operation. value describes the range to map and the
+// FIEMAP_FLAG_* request flags. Up to len(extents) extents are stored in
+// extents and value.Mapped_extents is set to the number filled in. If
+// extents is empty, the kernel only counts the extents in the range. See
+// the kernel's Documentation/filesystems/fiemap.rst for details.
+func IoctlFiemap(fd int, value *Fiemap, extents []FiemapExtent) error {
+	// Use a []uint64 so the buffer is suitably aligned for both structs.
+	buf := make([]uint64, (SizeofFiemap+len(extents)*SizeofFiemapExtent)/8)
+	raw := (*Fiemap)(unsafe.Pointer(&buf[0]))
+	*raw = *value
+	raw.Extent_count = uint32(len(extents))
+	raw.Mapped_extents = 0
+
+	err := ioctlPtr(fd, FS_IOC_FIEMAP, unsafe.Pointer(&buf[0]))
+
+	*value = *raw
+	if len(extents) > 0 {
+		rawext := unsafe.Slice((*FiemapExtent)(unsafe.Pointer(&buf[SizeofFiemap/8])), len(extents))
+		copy(extents, rawext[:min(int(raw.Mapped_extents), len(extents))])
+	}
+	return err
+}
+
+// FiemapExtents returns all extents of the file associated with fd that
+// overlap the length bytes starting at start, issuing as many
+// FS_IOC_FIEMAP operations as necessary. flags is a combination of
+// FIEMAP_FLAG_* values.
+func FiemapExtents(fd int, start, length uint64, flags uint32) ([]FiemapExtent, error) {
+	var extents []FiemapExtent
+	end := start + length
+	if end < start {
+		end = FIEMAP_MAX_OFFSET
+	}
+	buf := make([]FiemapExtent, 64)
+	for start < end {
+		value := Fiemap{Start: start, Length: end - start, Flags: flags}
+		if err := IoctlFiemap(fd, &value, buf); err != nil {
+			return nil, err
+		}
+		if value.Mapped_extents == 0 {
+			break
+		}
+		mapped := buf[:value.Mapped_extents]
+		extents = append(extents, mapped...)
+		last := mapped[len(mapped)-1]
+		if last.Last() {
+			break
+		}
+		start = last.Logical + last.Length
+	}
+	return extents, nil
+}
+
+// Last reports whether e is the last extent of the file.
+func (e *FiemapExtent) Last() bool { return e.Flags&FIEMAP_EXTENT_LAST != 0 }
+
+// Shared reports whether the blocks of e are shared with other files, for
+// example because they were reflinked.
+func (e *FiemapExtent) Shared() bool { return e.Flags&FIEMAP_EXTENT_SHARED != 0 }
+
+// Unwritten reports whether e is allocated but not yet written, so reads
+// of it return zeros.
+func (e *FiemapExtent) Unwritten() bool { return e.Flags&FIEMAP_EXTENT_UNWRITTEN != 0 }
+
+// Encoded reports whether the data of e is encoded, for example
+// compressed, so that Physical cannot be used to read it directly.
+func (e *FiemapExtent) Encoded() bool { return e.Flags&FIEMAP_EXTENT_ENCODED != 0 }
+
+// Unknown reports whether the physical location of e is not known, in
+// which case Physical is not valid.
+func (e *FiemapExtent) Unknown() bool { return e.Flags&FIEMAP_EXTENT_UNKNOWN != 0 }
+
 func IoctlHIDGetDesc(fd int, value *HIDRawReportDescriptor) error {
 	return ioctlPtr(fd, HIDIOCGRDESC, unsafe.Pointer(value))
 }
@@ -339,3 +412,81 @@ func IoctlLoopConfigure(fd int, value *LoopConfig) error {
 func IoctlPidfdInfo(fd int, info *PidfdInfo) error {
 	return ioctlPtr(fd, PIDFD_GET_INFO, unsafe.Pointer(info))
 }
+
+// IoctlFscryptGetPolicy retrieves the v1 encryption policy of the file or
+// directory associated with fd using the FS_IOC_GET_ENCRYPTION_POLICY
+// operation. Use IoctlFscryptGetPolicyEx for v2 policies.
+func IoctlFscryptGetPolicy(fd int) (*FscryptPolicyV1, error) {
+	var value FscryptPolicyV1
+	if err := ioctlPtr(fd, FS_IOC_GET_ENCRYPTION_POLICY, unsafe.Pointer(&value)); err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// IoctlFscryptGetPolicyEx retrieves the encryption policy of any version of
+// the file or directory associated with fd using the
+// FS_IOC_GET_ENCRYPTION_POLICY_EX operation. Use the V1 and V2 methods of
+// the result to access the policy.
+func IoctlFscryptGetPolicyEx(fd int) (*FscryptGetPolicyExArg, error) {
+	var value FscryptGetPolicyExArg
+	value.Size = uint64(len(value.Policy))
+	if err := ioctlPtr(fd, FS_IOC_GET_ENCRYPTION_POLICY_EX, unsafe.Pointer(&value)); err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// IoctlFscryptSetPolicy sets the v1 encryption policy of the empty directory
+// associated with fd using the FS_IOC_SET_ENCRYPTION_POLICY operation.
+func IoctlFscryptSetPolicy(fd int, policy *FscryptPolicyV1) error {
+	return ioctlPtr(fd, FS_IOC_SET_ENCRYPTION_POLICY, unsafe.Pointer(policy))
+}
+
+// IoctlFscryptSetPolicyV2 sets the v2 encryption policy of the empty
+// directory associated with fd using the FS_IOC_SET_ENCRYPTION_POLICY
+// operation.
+func IoctlFscryptSetPolicyV2(fd int, policy *FscryptPolicyV2) error {
+	return ioctlPtr(fd, FS_IOC_SET_ENCRYPTION_POLICY, unsafe.Pointer(policy))
+}
+
+// IoctlFscryptAddKey adds the master key raw to the filesystem containing
+// the file associated with fd using the FS_IOC_ADD_ENCRYPTION_KEY
+// operation. The Raw_size field of arg is set from raw. For v2 keys the
+// kernel stores the key identifier it computed in arg.Key_spec.
+func IoctlFscryptAddKey(fd int, arg *FscryptAddKeyArg, raw []byte) error {
+	size := unsafe.Sizeof(*arg)
+	buf := make([]byte, size+uintptr(len(raw)))
+	arg.Raw_size = uint32(len(raw))
+	*(*FscryptAddKeyArg)(unsafe.Pointer(&buf[0])) = *arg
+	copy(buf[size:], raw)
+
+	err := ioctlPtr(fd, FS_IOC_ADD_ENCRYPTION_KEY, unsafe.Pointer(&buf[0]))
+	*arg = *(*FscryptAddKeyArg)(unsafe.Pointer(&buf[0]))
+
+	// Don't leave a copy of the key material behind.
+	clear(buf)
+	return err
+}
+
+// IoctlFscryptRemoveKey removes the calling user's claim to the master key
+// specified by arg.Key_spec from the filesystem containing the file
+// associated with fd using the FS_IOC_REMOVE_ENCRYPTION_KEY operation.
+func IoctlFscryptRemoveKey(fd int, arg *FscryptRemoveKeyArg) error {
+	return ioctlPtr(fd, FS_IOC_REMOVE_ENCRYPTION_KEY, unsafe.Pointer(arg))
+}
+
+// IoctlFscryptRemoveKeyAllUsers removes all users' claims to the master key
+// specified by arg.Key_spec from the filesystem containing the file
+// associated with fd using the FS_IOC_REMOVE_ENCRYPTION_KEY_ALL_USERS
+// operation. It requires CAP_SYS_ADMIN.
+func IoctlFscryptRemoveKeyAllUsers(fd int, arg *FscryptRemoveKeyArg) error {
+	return ioctlPtr(fd, FS_IOC_REMOVE_ENCRYPTION_KEY_ALL_USERS, unsafe.Pointer(arg))
+}
+
+// IoctlFscryptGetKeyStatus retrieves the status of the master key specified
+// by arg.Key_spec in the filesystem containing the file associated with fd
+// using the FS_IOC_GET_ENCRYPTION_KEY_STATUS operation.
+func IoctlFscryptGetKeyStatus(fd int, arg *FscryptGetKeyStatusArg) error {
+	return ioctlPtr(fd, FS_IOC_GET_ENCRYPTION_KEY_STATUS, unsafe.Pointer(arg))
+}
@@ -0,0 +1,40 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix
+
+// Ioprio is an I/O scheduling priority as used by IoprioGet and IoprioSet.
+// It combines a scheduling class, one of the IOPRIO_CLASS_* constants, a
+// priority level within that class, where lower levels are served first,
+// and a hint, one of the IOPRIO_HINT_* constants. See ioprio_set(2).
+type Ioprio uint16
+
+// IoprioValue returns the I/O priority with the given class, level and
+// hint. Values out of range are truncated to the width of their field.
+func IoprioValue(class, level, hint int) Ioprio {
+	return Ioprio((class&IOPRIO_CLASS_MASK)<<IOPRIO_CLASS_SHIFT |
+		(hint&IOPRIO_HINT_MASK)<<IOPRIO_HINT_SHIFT |
+		level&IOPRIO_LEVEL_MASK)
+}
+
+// Class returns the scheduling class of p.
+func (p Ioprio) Class() int {
+	return int(p>>IOPRIO_CLASS_SHIFT) & IOPRIO_CLASS_MASK
+}
+
+// Level returns the priority level of p within its class.
+func (p Ioprio) Level() int {
+	return int(p) & IOPRIO_LEVEL_MASK
+}
+
+// Hint returns the hint of p.
+func (p Ioprio) Hint() int {
+	return int(p>>IOPRIO_HINT_SHIFT) & IOPRIO_HINT_MASK
+}
+
+// Data returns the class-specific data of p, which combines its level and
+// hint.
+func (p Ioprio) Data() int {
+	return int(p) & IOPRIO_PRIO_MASK
+}
@@ -98,6 +98,7 @@ struct termios2 {
 #include <asm/ptrace.h>
 
 #include <linux/audit.h>
+#include <linux/auxvec.h>
 #include <linux/blkpg.h>
 #include <linux/bpf.h>
 #include <linux/can.h>
@@ -109,10 +110,12 @@ struct termios2 {
 #include <linux/cryptouser.h>
 #include <linux/devlink.h>
 #include <linux/dm-ioctl.h>
+#include <linux/dqblk_xfs.h>
 #include <linux/errqueue.h>
 #include <linux/ethtool.h>
 #include <linux/ethtool_netlink.h>
 #include <linux/fanotify.h>
+#include <linux/fiemap.h>
 #include <linux/fib_rules.h>
 #include <linux/filter.h>
 #include <linux/fs.h>
@@ -131,14 +134,19 @@ struct termios2 {
 #include <linux/if_tun.h>
 #include <linux/if_xdp.h>
 #include <linux/inet_diag.h>
+#include <linux/ioprio.h>
 #include <linux/ipc.h>
 #include <linux/kcm.h>
+#include <linux/kcmp.h>
 #include <linux/keyctl.h>
 #include <linux/landlock.h>
 #include <linux/loop.h>
 #include <linux/lwtunnel.h>
+#include <linux/membarrier.h>
 #include <linux/mempolicy.h>
 #include <linux/mpls_iptunnel.h>
+#include <linux/mqueue.h>
+#include <linux/msg.h>
 #include <linux/ncsi.h>
 #include <linux/net_namespace.h>
 #include <linux/net_tstamp.h>
@@ -156,7 +164,9 @@ struct termios2 {
 #include <linux/perf_event.h>
 #include <linux/pps.h>
 #include <linux/ptp_clock.h>
+#include <linux/quota.h>
 #include <linux/random.h>
+#include <linux/rseq.h>
 #include <linux/rtc.h>
 #include <linux/rtnetlink.h>
 // This is to avoid a conflict of struct sched_param being defined by
@@ -164,6 +174,7 @@ struct termios2 {
 #define sched_param kernel_sched_param
 #include <linux/sched/types.h>
 #undef kernel_sched_param
+#include <linux/sem.h>
 #include <linux/shm.h>
 #include <linux/sock_diag.h>
 #include <linux/socket.h>
@@ -220,6 +231,16 @@ typedef struct {
 typedef struct stat my_stat;
 #endif
 
+// struct sigevent with the union flattened to expose the thread ID used
+// with SIGEV_THREAD_ID, and sigev_value as a pointer-sized integer.
+struct my_sigevent {
+	long sigev_value;
+	int sigev_signo;
+	int sigev_notify;
+	int sigev_tid;
+	int _pad[__SIGEV_PAD_SIZE - 1];
+};
+
 #ifdef TCSETS2
 // On systems that have "struct termios2" use this as type Termios.
 typedef struct termios2 termios_t;
@@ -456,6 +477,7 @@ struct my_can_bittiming_const {
 
 #if defined(__riscv)
 #include <asm/hwprobe.h>
+#include <asm/vendor/thead.h>
 #else
 
 // copied from /usr/include/asm/hwprobe.h
@@ -504,6 +526,28 @@ struct my_can_bittiming_const {
 #define		RISCV_HWPROBE_EXT_ZACAS		(1ULL << 34)
 #define		RISCV_HWPROBE_EXT_ZICOND	(1ULL << 35)
 #define		RISCV_HWPROBE_EXT_ZIHINTPAUSE	(1ULL << 36)
+#define		RISCV_HWPROBE_EXT_ZVE32X	(1ULL << 37)
+#define		RISCV_HWPROBE_EXT_ZVE32F	(1ULL << 38)
+#define		RISCV_HWPROBE_EXT_ZVE64X	(1ULL << 39)
+#define		RISCV_HWPROBE_EXT_ZVE64F	(1ULL << 40)
+#define		RISCV_HWPROBE_EXT_ZVE64D	(1ULL << 41)
+#define		RISCV_HWPROBE_EXT_ZIMOP	(1ULL << 42)
+#define		RISCV_HWPROBE_EXT_ZCA	(1ULL << 43)
+#define		RISCV_HWPROBE_EXT_ZCB	(1ULL << 44)
+#define		RISCV_HWPROBE_EXT_ZCD	(1ULL << 45)
+#define		RISCV_HWPROBE_EXT_ZCF	(1ULL << 46)
+#define		RISCV_HWPROBE_EXT_ZCMOP	(1ULL << 47)
+#define		RISCV_HWPROBE_EXT_ZAWRS	(1ULL << 48)
+#define		RISCV_HWPROBE_EXT_SUPM	(1ULL << 49)
+#define		RISCV_HWPROBE_EXT_ZICNTR	(1ULL << 50)
+#define		RISCV_HWPROBE_EXT_ZIHPM	(1ULL << 51)
+#define		RISCV_HWPROBE_EXT_ZFBFMIN	(1ULL << 52)
+#define		RISCV_HWPROBE_EXT_ZVFBFMIN	(1ULL << 53)
+#define		RISCV_HWPROBE_EXT_ZVFBFWMA	(1ULL << 54)
+#define		RISCV_HWPROBE_EXT_ZICBOM	(1ULL << 55)
+#define		RISCV_HWPROBE_EXT_ZAAMO	(1ULL << 56)
+#define		RISCV_HWPROBE_EXT_ZALRSC	(1ULL << 57)
+#define		RISCV_HWPROBE_EXT_ZABHA	(1ULL << 58)
 #define RISCV_HWPROBE_KEY_CPUPERF_0	5
 #define		RISCV_HWPROBE_MISALIGNED_UNKNOWN	(0 << 0)
 #define		RISCV_HWPROBE_MISALIGNED_EMULATED	(1 << 0)
@@ -512,8 +556,27 @@ struct my_can_bittiming_const {
 #define		RISCV_HWPROBE_MISALIGNED_UNSUPPORTED	(4 << 0)
 #define		RISCV_HWPROBE_MISALIGNED_MASK		(7 << 0)
 #define RISCV_HWPROBE_KEY_ZICBOZ_BLOCK_SIZE	6
+#define RISCV_HWPROBE_KEY_HIGHEST_VIRT_ADDRESS	7
+#define RISCV_HWPROBE_KEY_TIME_CSR_FREQ	8
+#define RISCV_HWPROBE_KEY_MISALIGNED_SCALAR_PERF	9
+#define		RISCV_HWPROBE_MISALIGNED_SCALAR_UNKNOWN	0
+#define		RISCV_HWPROBE_MISALIGNED_SCALAR_EMULATED	1
+#define		RISCV_HWPROBE_MISALIGNED_SCALAR_SLOW	2
+#define		RISCV_HWPROBE_MISALIGNED_SCALAR_FAST	3
+#define		RISCV_HWPROBE_MISALIGNED_SCALAR_UNSUPPORTED	4
+#define RISCV_HWPROBE_KEY_MISALIGNED_VECTOR_PERF	10
+#define		RISCV_HWPROBE_MISALIGNED_VECTOR_UNKNOWN	0
+#define		RISCV_HWPROBE_MISALIGNED_VECTOR_SLOW	2
+#define		RISCV_HWPROBE_MISALIGNED_VECTOR_FAST	3
+#define		RISCV_HWPROBE_MISALIGNED_VECTOR_UNSUPPORTED	4
+#define RISCV_HWPROBE_KEY_VENDOR_EXT_THEAD_0	11
+#define RISCV_HWPROBE_KEY_ZICBOM_BLOCK_SIZE	12
+#define RISCV_HWPROBE_KEY_VENDOR_EXT_SIFIVE_0	13
 #define RISCV_HWPROBE_WHICH_CPUS	(1 << 0)
 
+// copied from /usr/include/asm/vendor/thead.h
+#define	RISCV_HWPROBE_VENDOR_EXT_XTHEADVECTOR	(1 << 0)
+
 struct riscv_hwprobe {};
 #endif
 
@@ -662,6 +725,15 @@ const (
 	FILE_DEDUPE_RANGE_DIFFERS    = C.FILE_DEDUPE_RANGE_DIFFERS
 )
 
+type Fiemap C.struct_fiemap
+
+type FiemapExtent C.struct_fiemap_extent
+
+const (
+	SizeofFiemap       = C.sizeof_struct_fiemap
+	SizeofFiemapExtent = C.sizeof_struct_fiemap_extent
+)
+
 // Filesystem Encryption
 
 type FscryptPolicy C.struct_fscrypt_policy
@@ -1130,6 +1202,15 @@ type SignalfdSiginfo C.struct_signalfd_siginfo
 
 type Siginfo C.siginfo_t
 
+type Sigevent C.struct_my_sigevent
+
+const (
+	SIGEV_SIGNAL    = C.SIGEV_SIGNAL
+	SIGEV_NONE      = C.SIGEV_NONE
+	SIGEV_THREAD    = C.SIGEV_THREAD
+	SIGEV_THREAD_ID = C.SIGEV_THREAD_ID
+)
+
 // Terminal handling
 
 type Termios C.termios_t
@@ -4462,6 +4543,265 @@ const (
 	SHM_RND    = C.SHM_RND
 )
 
+// sem
+
+type Sembuf C.struct_sembuf
+type SysvSemDesc C.struct_semid64_ds
+
+const (
+	GETPID  = C.GETPID
+	GETVAL  = C.GETVAL
+	GETALL  = C.GETALL
+	GETNCNT = C.GETNCNT
+	GETZCNT = C.GETZCNT
+	SETVAL  = C.SETVAL
+	SETALL  = C.SETALL
+
+	SEM_UNDO = C.SEM_UNDO
+)
+
+// msg
+
+type SysvMsqDesc C.struct_msqid64_ds
+
+const (
+	MSG_NOERROR = C.MSG_NOERROR
+	MSG_EXCEPT  = C.MSG_EXCEPT
+	MSG_COPY    = C.MSG_COPY
+)
+
+// POSIX message queues
+
+type MqAttr C.struct_mq_attr
+
+// rseq
+
+type Rseq C.struct_rseq
+
+const SizeofRseq = C.sizeof_struct_rseq
+
+const (
+	RSEQ_CPU_ID_UNINITIALIZED       = C.RSEQ_CPU_ID_UNINITIALIZED
+	RSEQ_CPU_ID_REGISTRATION_FAILED = C.RSEQ_CPU_ID_REGISTRATION_FAILED
+
+	RSEQ_FLAG_UNREGISTER = C.RSEQ_FLAG_UNREGISTER
+
+	RSEQ_CS_FLAG_NO_RESTART_ON_PREEMPT = C.RSEQ_CS_FLAG_NO_RESTART_ON_PREEMPT
+	RSEQ_CS_FLAG_NO_RESTART_ON_SIGNAL  = C.RSEQ_CS_FLAG_NO_RESTART_ON_SIGNAL
+	RSEQ_CS_FLAG_NO_RESTART_ON_MIGRATE = C.RSEQ_CS_FLAG_NO_RESTART_ON_MIGRATE
+)
+
+// membarrier
+
+const (
+	MEMBARRIER_CMD_QUERY                                = C.MEMBARRIER_CMD_QUERY
+	MEMBARRIER_CMD_GLOBAL                               = C.MEMBARRIER_CMD_GLOBAL
+	MEMBARRIER_CMD_GLOBAL_EXPEDITED                     = C.MEMBARRIER_CMD_GLOBAL_EXPEDITED
+	MEMBARRIER_CMD_REGISTER_GLOBAL_EXPEDITED            = C.MEMBARRIER_CMD_REGISTER_GLOBAL_EXPEDITED
+	MEMBARRIER_CMD_PRIVATE_EXPEDITED                    = C.MEMBARRIER_CMD_PRIVATE_EXPEDITED
+	MEMBARRIER_CMD_REGISTER_PRIVATE_EXPEDITED           = C.MEMBARRIER_CMD_REGISTER_PRIVATE_EXPEDITED
+	MEMBARRIER_CMD_PRIVATE_EXPEDITED_SYNC_CORE          = C.MEMBARRIER_CMD_PRIVATE_EXPEDITED_SYNC_CORE
+	MEMBARRIER_CMD_REGISTER_PRIVATE_EXPEDITED_SYNC_CORE = C.MEMBARRIER_CMD_REGISTER_PRIVATE_EXPEDITED_SYNC_CORE
+	MEMBARRIER_CMD_PRIVATE_EXPEDITED_RSEQ               = C.MEMBARRIER_CMD_PRIVATE_EXPEDITED_RSEQ
+	MEMBARRIER_CMD_REGISTER_PRIVATE_EXPEDITED_RSEQ      = C.MEMBARRIER_CMD_REGISTER_PRIVATE_EXPEDITED_RSEQ
+	MEMBARRIER_CMD_SHARED                               = C.MEMBARRIER_CMD_SHARED
+
+	MEMBARRIER_CMD_FLAG_CPU = C.MEMBARRIER_CMD_FLAG_CPU
+)
+
+// I/O priorities
+
+const (
+	IOPRIO_CLASS_SHIFT = C.IOPRIO_CLASS_SHIFT
+	IOPRIO_NR_CLASSES  = C.IOPRIO_NR_CLASSES
+	IOPRIO_CLASS_MASK  = C.IOPRIO_CLASS_MASK
+	IOPRIO_PRIO_MASK   = C.IOPRIO_PRIO_MASK
+
+	IOPRIO_CLASS_NONE    = C.IOPRIO_CLASS_NONE
+	IOPRIO_CLASS_RT      = C.IOPRIO_CLASS_RT
+	IOPRIO_CLASS_BE      = C.IOPRIO_CLASS_BE
+	IOPRIO_CLASS_IDLE    = C.IOPRIO_CLASS_IDLE
+	IOPRIO_CLASS_INVALID = C.IOPRIO_CLASS_INVALID
+
+	IOPRIO_LEVEL_NR_BITS = C.IOPRIO_LEVEL_NR_BITS
+	IOPRIO_NR_LEVELS     = C.IOPRIO_NR_LEVELS
+	IOPRIO_LEVEL_MASK    = C.IOPRIO_LEVEL_MASK
+	IOPRIO_BE_NR         = C.IOPRIO_BE_NR
+	IOPRIO_NORM          = C.IOPRIO_NORM
+	IOPRIO_BE_NORM       = C.IOPRIO_BE_NORM
+
+	IOPRIO_WHO_PROCESS = C.IOPRIO_WHO_PROCESS
+	IOPRIO_WHO_PGRP    = C.IOPRIO_WHO_PGRP
+	IOPRIO_WHO_USER    = C.IOPRIO_WHO_USER
+
+	IOPRIO_HINT_SHIFT                = C.IOPRIO_HINT_SHIFT
+	IOPRIO_HINT_NR_BITS              = C.IOPRIO_HINT_NR_BITS
+	IOPRIO_NR_HINTS                  = C.IOPRIO_NR_HINTS
+	IOPRIO_HINT_MASK                 = C.IOPRIO_HINT_MASK
+	IOPRIO_HINT_NONE                 = C.IOPRIO_HINT_NONE
+	IOPRIO_HINT_DEV_DURATION_LIMIT_1 = C.IOPRIO_HINT_DEV_DURATION_LIMIT_1
+	IOPRIO_HINT_DEV_DURATION_LIMIT_2 = C.IOPRIO_HINT_DEV_DURATION_LIMIT_2
+	IOPRIO_HINT_DEV_DURATION_LIMIT_3 = C.IOPRIO_HINT_DEV_DURATION_LIMIT_3
+	IOPRIO_HINT_DEV_DURATION_LIMIT_4 = C.IOPRIO_HINT_DEV_DURATION_LIMIT_4
+	IOPRIO_HINT_DEV_DURATION_LIMIT_5 = C.IOPRIO_HINT_DEV_DURATION_LIMIT_5
+	IOPRIO_HINT_DEV_DURATION_LIMIT_6 = C.IOPRIO_HINT_DEV_DURATION_LIMIT_6
+	IOPRIO_HINT_DEV_DURATION_LIMIT_7 = C.IOPRIO_HINT_DEV_DURATION_LIMIT_7
+)
+
+// kcmp
+
+type KcmpEpollSlot C.struct_kcmp_epoll_slot
+
+const (
+	KCMP_FILE      = C.KCMP_FILE
+	KCMP_VM        = C.KCMP_VM
+	KCMP_FILES     = C.KCMP_FILES
+	KCMP_FS        = C.KCMP_FS
+	KCMP_SIGHAND   = C.KCMP_SIGHAND
+	KCMP_IO        = C.KCMP_IO
+	KCMP_SYSVSEM   = C.KCMP_SYSVSEM
+	KCMP_EPOLL_TFD = C.KCMP_EPOLL_TFD
+)
+
+// Auxiliary vector
+
+const (
+	AT_NULL              = C.AT_NULL
+	AT_IGNORE            = C.AT_IGNORE
+	AT_EXECFD            = C.AT_EXECFD
+	AT_PHDR              = C.AT_PHDR
+	AT_PHENT             = C.AT_PHENT
+	AT_PHNUM             = C.AT_PHNUM
+	AT_PAGESZ            = C.AT_PAGESZ
+	AT_BASE              = C.AT_BASE
+	AT_FLAGS             = C.AT_FLAGS
+	AT_ENTRY             = C.AT_ENTRY
+	AT_NOTELF            = C.AT_NOTELF
+	AT_UID               = C.AT_UID
+	AT_EUID              = C.AT_EUID
+	AT_GID               = C.AT_GID
+	AT_EGID              = C.AT_EGID
+	AT_PLATFORM          = C.AT_PLATFORM
+	AT_HWCAP             = C.AT_HWCAP
+	AT_CLKTCK            = C.AT_CLKTCK
+	AT_SECURE            = C.AT_SECURE
+	AT_BASE_PLATFORM     = C.AT_BASE_PLATFORM
+	AT_RANDOM            = C.AT_RANDOM
+	AT_HWCAP2            = C.AT_HWCAP2
+	AT_RSEQ_FEATURE_SIZE = C.AT_RSEQ_FEATURE_SIZE
+	AT_RSEQ_ALIGN        = C.AT_RSEQ_ALIGN
+	AT_HWCAP3            = C.AT_HWCAP3
+	AT_HWCAP4            = C.AT_HWCAP4
+	AT_EXECFN            = C.AT_EXECFN
+	AT_SYSINFO_EHDR      = C.AT_SYSINFO_EHDR
+	AT_MINSIGSTKSZ       = C.AT_MINSIGSTKSZ
+)
+
+// Quotas
+
+type Dqblk C.struct_if_dqblk
+
+type Nextdqblk C.struct_if_nextdqblk
+
+type Dqinfo C.struct_if_dqinfo
+
+type FsDiskQuota C.struct_fs_disk_quota
+
+type FsQfilestat C.struct_fs_qfilestat
+
+type FsQuotaStat C.struct_fs_quota_stat
+
+const (
+	USRQUOTA = C.USRQUOTA
+	GRPQUOTA = C.GRPQUOTA
+	PRJQUOTA = C.PRJQUOTA
+
+	SUBCMDMASK  = C.SUBCMDMASK
+	SUBCMDSHIFT = C.SUBCMDSHIFT
+
+	Q_SYNC         = C.Q_SYNC
+	Q_QUOTAON      = C.Q_QUOTAON
+	Q_QUOTAOFF     = C.Q_QUOTAOFF
+	Q_GETFMT       = C.Q_GETFMT
+	Q_GETINFO      = C.Q_GETINFO
+	Q_SETINFO      = C.Q_SETINFO
+	Q_GETQUOTA     = C.Q_GETQUOTA
+	Q_SETQUOTA     = C.Q_SETQUOTA
+	Q_GETNEXTQUOTA = C.Q_GETNEXTQUOTA
+
+	QFMT_VFS_OLD = C.QFMT_VFS_OLD
+	QFMT_VFS_V0  = C.QFMT_VFS_V0
+	QFMT_OCFS2   = C.QFMT_OCFS2
+	QFMT_VFS_V1  = C.QFMT_VFS_V1
+	QFMT_SHMEM   = C.QFMT_SHMEM
+
+	QIF_DQBLKSIZE_BITS = C.QIF_DQBLKSIZE_BITS
+	QIF_DQBLKSIZE      = C.QIF_DQBLKSIZE
+	QIF_BLIMITS        = C.QIF_BLIMITS
+	QIF_SPACE          = C.QIF_SPACE
+	QIF_ILIMITS        = C.QIF_ILIMITS
+	QIF_INODES         = C.QIF_INODES
+	QIF_BTIME          = C.QIF_BTIME
+	QIF_ITIME          = C.QIF_ITIME
+	QIF_LIMITS         = C.QIF_LIMITS
+	QIF_USAGE          = C.QIF_USAGE
+	QIF_TIMES          = C.QIF_TIMES
+	QIF_ALL            = C.QIF_ALL
+
+	IIF_BGRACE = C.IIF_BGRACE
+	IIF_IGRACE = C.IIF_IGRACE
+	IIF_FLAGS  = C.IIF_FLAGS
+	IIF_ALL    = C.IIF_ALL
+
+	DQF_ROOT_SQUASH = C.DQF_ROOT_SQUASH
+	DQF_SYS_FILE    = C.DQF_SYS_FILE
+
+	Q_XQUOTAON      = C.Q_XQUOTAON
+	Q_XQUOTAOFF     = C.Q_XQUOTAOFF
+	Q_XGETQUOTA     = C.Q_XGETQUOTA
+	Q_XSETQLIM      = C.Q_XSETQLIM
+	Q_XGETQSTAT     = C.Q_XGETQSTAT
+	Q_XQUOTARM      = C.Q_XQUOTARM
+	Q_XQUOTASYNC    = C.Q_XQUOTASYNC
+	Q_XGETQSTATV    = C.Q_XGETQSTATV
+	Q_XGETNEXTQUOTA = C.Q_XGETNEXTQUOTA
+
+	FS_DQUOT_VERSION = C.FS_DQUOT_VERSION
+	FS_QSTAT_VERSION = C.FS_QSTAT_VERSION
+
+	FS_USER_QUOTA  = C.FS_USER_QUOTA
+	FS_PROJ_QUOTA  = C.FS_PROJ_QUOTA
+	FS_GROUP_QUOTA = C.FS_GROUP_QUOTA
+
+	FS_DQ_ISOFT      = C.FS_DQ_ISOFT
+	FS_DQ_IHARD      = C.FS_DQ_IHARD
+	FS_DQ_BSOFT      = C.FS_DQ_BSOFT
+	FS_DQ_BHARD      = C.FS_DQ_BHARD
+	FS_DQ_RTBSOFT    = C.FS_DQ_RTBSOFT
+	FS_DQ_RTBHARD    = C.FS_DQ_RTBHARD
+	FS_DQ_LIMIT_MASK = C.FS_DQ_LIMIT_MASK
+	FS_DQ_BTIMER     = C.FS_DQ_BTIMER
+	FS_DQ_ITIMER     = C.FS_DQ_ITIMER
+	FS_DQ_RTBTIMER   = C.FS_DQ_RTBTIMER
+	FS_DQ_TIMER_MASK = C.FS_DQ_TIMER_MASK
+	FS_DQ_BWARNS     = C.FS_DQ_BWARNS
+	FS_DQ_IWARNS     = C.FS_DQ_IWARNS
+	FS_DQ_RTBWARNS   = C.FS_DQ_RTBWARNS
+	FS_DQ_WARNS_MASK = C.FS_DQ_WARNS_MASK
+	FS_DQ_BCOUNT     = C.FS_DQ_BCOUNT
+	FS_DQ_ICOUNT     = C.FS_DQ_ICOUNT
+	FS_DQ_RTBCOUNT   = C.FS_DQ_RTBCOUNT
+	FS_DQ_ACCT_MASK  = C.FS_DQ_ACCT_MASK
+	FS_DQ_BIGTIME    = C.FS_DQ_BIGTIME
+
+	FS_QUOTA_UDQ_ACCT = C.FS_QUOTA_UDQ_ACCT
+	FS_QUOTA_UDQ_ENFD = C.FS_QUOTA_UDQ_ENFD
+	FS_QUOTA_GDQ_ACCT = C.FS_QUOTA_GDQ_ACCT
+	FS_QUOTA_GDQ_ENFD = C.FS_QUOTA_GDQ_ENFD
+	FS_QUOTA_PDQ_ACCT = C.FS_QUOTA_PDQ_ACCT
+	FS_QUOTA_PDQ_ENFD = C.FS_QUOTA_PDQ_ENFD
+)
+
 // mount_setattr
 
 type MountAttr C.struct_mount_attr
@@ -6211,58 +6551,97 @@ type RISCVHWProbePairs C.struct_riscv_hwprobe
 // generated by:
 // perl -nlE '/^#define\s+(RISCV_HWPROBE_\w+)/ && say "$1 = C.$1"' /tmp/riscv64/include/asm/hwprobe.h
 const (
-	RISCV_HWPROBE_KEY_MVENDORID          = C.RISCV_HWPROBE_KEY_MVENDORID
-	RISCV_HWPROBE_KEY_MARCHID            = C.RISCV_HWPROBE_KEY_MARCHID
-	RISCV_HWPROBE_KEY_MIMPID             = C.RISCV_HWPROBE_KEY_MIMPID
-	RISCV_HWPROBE_KEY_BASE_BEHAVIOR      = C.RISCV_HWPROBE_KEY_BASE_BEHAVIOR
-	RISCV_HWPROBE_BASE_BEHAVIOR_IMA      = C.RISCV_HWPROBE_BASE_BEHAVIOR_IMA
-	RISCV_HWPROBE_KEY_IMA_EXT_0          = C.RISCV_HWPROBE_KEY_IMA_EXT_0
-	RISCV_HWPROBE_IMA_FD                 = C.RISCV_HWPROBE_IMA_FD
-	RISCV_HWPROBE_IMA_C                  = C.RISCV_HWPROBE_IMA_C
-	RISCV_HWPROBE_IMA_V                  = C.RISCV_HWPROBE_IMA_V
-	RISCV_HWPROBE_EXT_ZBA                = C.RISCV_HWPROBE_EXT_ZBA
-	RISCV_HWPROBE_EXT_ZBB                = C.RISCV_HWPROBE_EXT_ZBB
-	RISCV_HWPROBE_EXT_ZBS                = C.RISCV_HWPROBE_EXT_ZBS
-	RISCV_HWPROBE_EXT_ZICBOZ             = C.RISCV_HWPROBE_EXT_ZICBOZ
-	RISCV_HWPROBE_EXT_ZBC                = C.RISCV_HWPROBE_EXT_ZBC
-	RISCV_HWPROBE_EXT_ZBKB               = C.RISCV_HWPROBE_EXT_ZBKB
-	RISCV_HWPROBE_EXT_ZBKC               = C.RISCV_HWPROBE_EXT_ZBKC
-	RISCV_HWPROBE_EXT_ZBKX               = C.RISCV_HWPROBE_EXT_ZBKX
-	RISCV_HWPROBE_EXT_ZKND               = C.RISCV_HWPROBE_EXT_ZKND
-	RISCV_HWPROBE_EXT_ZKNE               = C.RISCV_HWPROBE_EXT_ZKNE
-	RISCV_HWPROBE_EXT_ZKNH               = C.RISCV_HWPROBE_EXT_ZKNH
-	RISCV_HWPROBE_EXT_ZKSED              = C.RISCV_HWPROBE_EXT_ZKSED
-	RISCV_HWPROBE_EXT_ZKSH               = C.RISCV_HWPROBE_EXT_ZKSH
-	RISCV_HWPROBE_EXT_ZKT                = C.RISCV_HWPROBE_EXT_ZKT
-	RISCV_HWPROBE_EXT_ZVBB               = C.RISCV_HWPROBE_EXT_ZVBB
-	RISCV_HWPROBE_EXT_ZVBC               = C.RISCV_HWPROBE_EXT_ZVBC
-	RISCV_HWPROBE_EXT_ZVKB               = C.RISCV_HWPROBE_EXT_ZVKB
-	RISCV_HWPROBE_EXT_ZVKG               = C.RISCV_HWPROBE_EXT_ZVKG
-	RISCV_HWPROBE_EXT_ZVKNED             = C.RISCV_HWPROBE_EXT_ZVKNED
-	RISCV_HWPROBE_EXT_ZVKNHA             = C.RISCV_HWPROBE_EXT_ZVKNHA
-	RISCV_HWPROBE_EXT_ZVKNHB             = C.RISCV_HWPROBE_EXT_ZVKNHB
-	RISCV_HWPROBE_EXT_ZVKSED             = C.RISCV_HWPROBE_EXT_ZVKSED
-	RISCV_HWPROBE_EXT_ZVKSH              = C.RISCV_HWPROBE_EXT_ZVKSH
-	RISCV_HWPROBE_EXT_ZVKT               = C.RISCV_HWPROBE_EXT_ZVKT
-	RISCV_HWPROBE_EXT_ZFH                = C.RISCV_HWPROBE_EXT_ZFH
-	RISCV_HWPROBE_EXT_ZFHMIN             = C.RISCV_HWPROBE_EXT_ZFHMIN
-	RISCV_HWPROBE_EXT_ZIHINTNTL          = C.RISCV_HWPROBE_EXT_ZIHINTNTL
-	RISCV_HWPROBE_EXT_ZVFH               = C.RISCV_HWPROBE_EXT_ZVFH
-	RISCV_HWPROBE_EXT_ZVFHMIN            = C.RISCV_HWPROBE_EXT_ZVFHMIN
-	RISCV_HWPROBE_EXT_ZFA                = C.RISCV_HWPROBE_EXT_ZFA
-	RISCV_HWPROBE_EXT_ZTSO               = C.RISCV_HWPROBE_EXT_ZTSO
-	RISCV_HWPROBE_EXT_ZACAS              = C.RISCV_HWPROBE_EXT_ZACAS
-	RISCV_HWPROBE_EXT_ZICOND             = C.RISCV_HWPROBE_EXT_ZICOND
-	RISCV_HWPROBE_EXT_ZIHINTPAUSE        = C.RISCV_HWPROBE_EXT_ZIHINTPAUSE
-	RISCV_HWPROBE_KEY_CPUPERF_0          = C.RISCV_HWPROBE_KEY_CPUPERF_0
-	RISCV_HWPROBE_MISALIGNED_UNKNOWN     = C.RISCV_HWPROBE_MISALIGNED_UNKNOWN
-	RISCV_HWPROBE_MISALIGNED_EMULATED    = C.RISCV_HWPROBE_MISALIGNED_EMULATED
-	RISCV_HWPROBE_MISALIGNED_SLOW        = C.RISCV_HWPROBE_MISALIGNED_SLOW
-	RISCV_HWPROBE_MISALIGNED_FAST        = C.RISCV_HWPROBE_MISALIGNED_FAST
-	RISCV_HWPROBE_MISALIGNED_UNSUPPORTED = C.RISCV_HWPROBE_MISALIGNED_UNSUPPORTED
-	RISCV_HWPROBE_MISALIGNED_MASK        = C.RISCV_HWPROBE_MISALIGNED_MASK
-	RISCV_HWPROBE_KEY_ZICBOZ_BLOCK_SIZE  = C.RISCV_HWPROBE_KEY_ZICBOZ_BLOCK_SIZE
-	RISCV_HWPROBE_WHICH_CPUS             = C.RISCV_HWPROBE_WHICH_CPUS
+	RISCV_HWPROBE_KEY_MVENDORID                 = C.RISCV_HWPROBE_KEY_MVENDORID
+	RISCV_HWPROBE_KEY_MARCHID                   = C.RISCV_HWPROBE_KEY_MARCHID
+	RISCV_HWPROBE_KEY_MIMPID                    = C.RISCV_HWPROBE_KEY_MIMPID
+	RISCV_HWPROBE_KEY_BASE_BEHAVIOR             = C.RISCV_HWPROBE_KEY_BASE_BEHAVIOR
+	RISCV_HWPROBE_BASE_BEHAVIOR_IMA             = C.RISCV_HWPROBE_BASE_BEHAVIOR_IMA
+	RISCV_HWPROBE_KEY_IMA_EXT_0                 = C.RISCV_HWPROBE_KEY_IMA_EXT_0
+	RISCV_HWPROBE_IMA_FD                        = C.RISCV_HWPROBE_IMA_FD
+	RISCV_HWPROBE_IMA_C                         = C.RISCV_HWPROBE_IMA_C
+	RISCV_HWPROBE_IMA_V                         = C.RISCV_HWPROBE_IMA_V
+	RISCV_HWPROBE_EXT_ZBA                       = C.RISCV_HWPROBE_EXT_ZBA
+	RISCV_HWPROBE_EXT_ZBB                       = C.RISCV_HWPROBE_EXT_ZBB
+	RISCV_HWPROBE_EXT_ZBS                       = C.RISCV_HWPROBE_EXT_ZBS
+	RISCV_HWPROBE_EXT_ZICBOZ                    = C.RISCV_HWPROBE_EXT_ZICBOZ
+	RISCV_HWPROBE_EXT_ZBC                       = C.RISCV_HWPROBE_EXT_ZBC
+	RISCV_HWPROBE_EXT_ZBKB                      = C.RISCV_HWPROBE_EXT_ZBKB
+	RISCV_HWPROBE_EXT_ZBKC                      = C.RISCV_HWPROBE_EXT_ZBKC
+	RISCV_HWPROBE_EXT_ZBKX                      = C.RISCV_HWPROBE_EXT_ZBKX
+	RISCV_HWPROBE_EXT_ZKND                      = C.RISCV_HWPROBE_EXT_ZKND
+	RISCV_HWPROBE_EXT_ZKNE                      = C.RISCV_HWPROBE_EXT_ZKNE
+	RISCV_HWPROBE_EXT_ZKNH                      = C.RISCV_HWPROBE_EXT_ZKNH
+	RISCV_HWPROBE_EXT_ZKSED                     = C.RISCV_HWPROBE_EXT_ZKSED
+	RISCV_HWPROBE_EXT_ZKSH                      = C.RISCV_HWPROBE_EXT_ZKSH
+	RISCV_HWPROBE_EXT_ZKT                       = C.RISCV_HWPROBE_EXT_ZKT
+	RISCV_HWPROBE_EXT_ZVBB                      = C.RISCV_HWPROBE_EXT_ZVBB
+	RISCV_HWPROBE_EXT_ZVBC                      = C.RISCV_HWPROBE_EXT_ZVBC
+	RISCV_HWPROBE_EXT_ZVKB                      = C.RISCV_HWPROBE_EXT_ZVKB
+	RISCV_HWPROBE_EXT_ZVKG                      = C.RISCV_HWPROBE_EXT_ZVKG
+	RISCV_HWPROBE_EXT_ZVKNED                    = C.RISCV_HWPROBE_EXT_ZVKNED
+	RISCV_HWPROBE_EXT_ZVKNHA                    = C.RISCV_HWPROBE_EXT_ZVKNHA
+	RISCV_HWPROBE_EXT_ZVKNHB                    = C.RISCV_HWPROBE_EXT_ZVKNHB
+	RISCV_HWPROBE_EXT_ZVKSED                    = C.RISCV_HWPROBE_EXT_ZVKSED
+	RISCV_HWPROBE_EXT_ZVKSH                     = C.RISCV_HWPROBE_EXT_ZVKSH
+	RISCV_HWPROBE_EXT_ZVKT                      = C.RISCV_HWPROBE_EXT_ZVKT
+	RISCV_HWPROBE_EXT_ZFH                       = C.RISCV_HWPROBE_EXT_ZFH
+	RISCV_HWPROBE_EXT_ZFHMIN                    = C.RISCV_HWPROBE_EXT_ZFHMIN
+	RISCV_HWPROBE_EXT_ZIHINTNTL                 = C.RISCV_HWPROBE_EXT_ZIHINTNTL
+	RISCV_HWPROBE_EXT_ZVFH                      = C.RISCV_HWPROBE_EXT_ZVFH
+	RISCV_HWPROBE_EXT_ZVFHMIN                   = C.RISCV_HWPROBE_EXT_ZVFHMIN
+	RISCV_HWPROBE_EXT_ZFA                       = C.RISCV_HWPROBE_EXT_ZFA
+	RISCV_HWPROBE_EXT_ZTSO                      = C.RISCV_HWPROBE_EXT_ZTSO
+	RISCV_HWPROBE_EXT_ZACAS                     = C.RISCV_HWPROBE_EXT_ZACAS
+	RISCV_HWPROBE_EXT_ZICOND                    = C.RISCV_HWPROBE_EXT_ZICOND
+	RISCV_HWPROBE_EXT_ZIHINTPAUSE               = C.RISCV_HWPROBE_EXT_ZIHINTPAUSE
+	RISCV_HWPROBE_EXT_ZVE32X                    = C.RISCV_HWPROBE_EXT_ZVE32X
+	RISCV_HWPROBE_EXT_ZVE32F                    = C.RISCV_HWPROBE_EXT_ZVE32F
+	RISCV_HWPROBE_EXT_ZVE64X                    = C.RISCV_HWPROBE_EXT_ZVE64X
+	RISCV_HWPROBE_EXT_ZVE64F                    = C.RISCV_HWPROBE_EXT_ZVE64F
+	RISCV_HWPROBE_EXT_ZVE64D                    = C.RISCV_HWPROBE_EXT_ZVE64D
+	RISCV_HWPROBE_EXT_ZIMOP                     = C.RISCV_HWPROBE_EXT_ZIMOP
+	RISCV_HWPROBE_EXT_ZCA                       = C.RISCV_HWPROBE_EXT_ZCA
+	RISCV_HWPROBE_EXT_ZCB                       = C.RISCV_HWPROBE_EXT_ZCB
+	RISCV_HWPROBE_EXT_ZCD                       = C.RISCV_HWPROBE_EXT_ZCD
+	RISCV_HWPROBE_EXT_ZCF                       = C.RISCV_HWPROBE_EXT_ZCF
+	RISCV_HWPROBE_EXT_ZCMOP                     = C.RISCV_HWPROBE_EXT_ZCMOP
+	RISCV_HWPROBE_EXT_ZAWRS                     = C.RISCV_HWPROBE_EXT_ZAWRS
+	RISCV_HWPROBE_EXT_SUPM                      = C.RISCV_HWPROBE_EXT_SUPM
+	RISCV_HWPROBE_EXT_ZICNTR                    = C.RISCV_HWPROBE_EXT_ZICNTR
+	RISCV_HWPROBE_EXT_ZIHPM                     = C.RISCV_HWPROBE_EXT_ZIHPM
+	RISCV_HWPROBE_EXT_ZFBFMIN                   = C.RISCV_HWPROBE_EXT_ZFBFMIN
+	RISCV_HWPROBE_EXT_ZVFBFMIN                  = C.RISCV_HWPROBE_EXT_ZVFBFMIN
+	RISCV_HWPROBE_EXT_ZVFBFWMA                  = C.RISCV_HWPROBE_EXT_ZVFBFWMA
+	RISCV_HWPROBE_EXT_ZICBOM                    = C.RISCV_HWPROBE_EXT_ZICBOM
+	RISCV_HWPROBE_EXT_ZAAMO                     = C.RISCV_HWPROBE_EXT_ZAAMO
+	RISCV_HWPROBE_EXT_ZALRSC                    = C.RISCV_HWPROBE_EXT_ZALRSC
+	RISCV_HWPROBE_EXT_ZABHA                     = C.RISCV_HWPROBE_EXT_ZABHA
+	RISCV_HWPROBE_KEY_CPUPERF_0                 = C.RISCV_HWPROBE_KEY_CPUPERF_0
+	RISCV_HWPROBE_MISALIGNED_UNKNOWN            = C.RISCV_HWPROBE_MISALIGNED_UNKNOWN
+	RISCV_HWPROBE_MISALIGNED_EMULATED           = C.RISCV_HWPROBE_MISALIGNED_EMULATED
+	RISCV_HWPROBE_MISALIGNED_SLOW               = C.RISCV_HWPROBE_MISALIGNED_SLOW
+	RISCV_HWPROBE_MISALIGNED_FAST               = C.RISCV_HWPROBE_MISALIGNED_FAST
+	RISCV_HWPROBE_MISALIGNED_UNSUPPORTED        = C.RISCV_HWPROBE_MISALIGNED_UNSUPPORTED
+	RISCV_HWPROBE_MISALIGNED_MASK               = C.RISCV_HWPROBE_MISALIGNED_MASK
+	RISCV_HWPROBE_KEY_ZICBOZ_BLOCK_SIZE         = C.RISCV_HWPROBE_KEY_ZICBOZ_BLOCK_SIZE
+	RISCV_HWPROBE_KEY_HIGHEST_VIRT_ADDRESS      = C.RISCV_HWPROBE_KEY_HIGHEST_VIRT_ADDRESS
+	RISCV_HWPROBE_KEY_TIME_CSR_FREQ             = C.RISCV_HWPROBE_KEY_TIME_CSR_FREQ
+	RISCV_HWPROBE_KEY_MISALIGNED_SCALAR_PERF    = C.RISCV_HWPROBE_KEY_MISALIGNED_SCALAR_PERF
+	RISCV_HWPROBE_MISALIGNED_SCALAR_UNKNOWN     = C.RISCV_HWPROBE_MISALIGNED_SCALAR_UNKNOWN
+	RISCV_HWPROBE_MISALIGNED_SCALAR_EMULATED    = C.RISCV_HWPROBE_MISALIGNED_SCALAR_EMULATED
+	RISCV_HWPROBE_MISALIGNED_SCALAR_SLOW        = C.RISCV_HWPROBE_MISALIGNED_SCALAR_SLOW
+	RISCV_HWPROBE_MISALIGNED_SCALAR_FAST        = C.RISCV_HWPROBE_MISALIGNED_SCALAR_FAST
+	RISCV_HWPROBE_MISALIGNED_SCALAR_UNSUPPORTED = C.RISCV_HWPROBE_MISALIGNED_SCALAR_UNSUPPORTED
+	RISCV_HWPROBE_KEY_MISALIGNED_VECTOR_PERF    = C.RISCV_HWPROBE_KEY_MISALIGNED_VECTOR_PERF
+	RISCV_HWPROBE_MISALIGNED_VECTOR_UNKNOWN     = C.RISCV_HWPROBE_MISALIGNED_VECTOR_UNKNOWN
+	RISCV_HWPROBE_MISALIGNED_VECTOR_SLOW        = C.RISCV_HWPROBE_MISALIGNED_VECTOR_SLOW
+	RISCV_HWPROBE_MISALIGNED_VECTOR_FAST        = C.RISCV_HWPROBE_MISALIGNED_VECTOR_FAST
+	RISCV_HWPROBE_MISALIGNED_VECTOR_UNSUPPORTED = C.RISCV_HWPROBE_MISALIGNED_VECTOR_UNSUPPORTED
+	RISCV_HWPROBE_KEY_VENDOR_EXT_THEAD_0        = C.RISCV_HWPROBE_KEY_VENDOR_EXT_THEAD_0
+	RISCV_HWPROBE_KEY_ZICBOM_BLOCK_SIZE         = C.RISCV_HWPROBE_KEY_ZICBOM_BLOCK_SIZE
+	RISCV_HWPROBE_KEY_VENDOR_EXT_SIFIVE_0       = C.RISCV_HWPROBE_KEY_VENDOR_EXT_SIFIVE_0
+	RISCV_HWPROBE_WHICH_CPUS                    = C.RISCV_HWPROBE_WHICH_CPUS
+	RISCV_HWPROBE_VENDOR_EXT_XTHEADVECTOR       = C.RISCV_HWPROBE_VENDOR_EXT_XTHEADVECTOR
 )
 
 type SchedAttr C.struct_sched_attr
@@ -0,0 +1,54 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix
+
+import "unsafe"
+
+const mapFixedNoreplace = MAP_FIXED_NOREPLACE
+
+func adviseHugePages(b []byte) error {
+	return Madvise(b, MADV_HUGEPAGE)
+}
+
+// remap grows m with mremap(2), keeping its guard pages by moving it into
+// a newly reserved region. mremap(2) fails with EFAULT if the mapping spans
+// several kernel memory areas, as it may after a range was protected,
+// locked or advised, and older kernels then unmap the destination before
+// failing; m is mapped again instead in those cases.
+func (m *Mapping) remap(size int) ([]byte, error) {
+	if m.split {
+		return m.copyRemap(size)
+	}
+	length := uintptr(pageRound(size))
+	old, oldLength := uintptr(unsafe.Pointer(&m.mem[0])), uintptr(len(m.mem))
+	var p uintptr
+	var err error
+	if m.guard == 0 {
+		p, err = mremap(old, oldLength, length, MREMAP_MAYMOVE, 0)
+	} else {
+		guard := uintptr(m.guard)
+		var base uintptr
+		base, err = mmap(0, length+2*guard, PROT_NONE, MAP_PRIVATE|MAP_ANON, -1, 0)
+		if err != nil {
+			return nil, err
+		}
+		p, err = mremap(old, oldLength, length, MREMAP_MAYMOVE|MREMAP_FIXED, base+guard)
+		if err != nil {
+			munmap(base, length+2*guard)
+		} else {
+			// Only unmap the old guard regions, as the hole between
+			// them may already be reused.
+			munmap(old-guard, guard)
+			munmap(old+oldLength, guard)
+		}
+	}
+	if err == EFAULT {
+		return m.copyRemap(size)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return unsafe.Slice((*byte)(unsafe.Pointer(p)), length), nil
+}
@@ -0,0 +1,327 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+
+package unix
+
+import "unsafe"
+
+// MappingOptions holds optional settings for NewMapping.
+type MappingOptions struct {
+	// Addr is the address at which to place the mapping. It is a hint
+	// unless Fixed is set.
+	Addr uintptr
+
+	// Fixed requires the mapping to be placed exactly at Addr, which must
+	// be page aligned, and fails with EEXIST if that range is already in
+	// use. Unlike MAP_FIXED, it never replaces existing mappings. It uses
+	// MAP_FIXED_NOREPLACE on Linux.
+	Fixed bool
+
+	// HugePages asks for the mapping to be backed by transparent huge
+	// pages with MADV_HUGEPAGE on Linux. It is ignored on other systems.
+	// Use MAP_HUGETLB for pages from the hugetlbfs pool instead.
+	HugePages bool
+
+	// GuardPages is the number of inaccessible pages placed before and
+	// after the mapping, so that accesses just outside it fault.
+	GuardPages int
+}
+
+// Mapping is a region of memory mapped with mmap(2), which it owns until
+// Unmap is called. Unlike the slices returned by Mmap, it can be resized
+// and manages its own guard pages.
+//
+// The methods taking a range operate on the n bytes at offset off in the
+// mapping, extended to whole pages, as the system calls work on pages.
+//
+// A Mapping is not safe for concurrent use.
+type Mapping struct {
+	mem    []byte // mapped region, a whole number of pages
+	size   int    // requested size, at most len(mem)
+	guard  int    // size in bytes of the guard region on each side
+	fd     int
+	offset int64
+	prot   int
+	flags  int
+	huge   bool
+
+	rangeProt bool // Protect was called, so pages may lack prot
+	split     bool // Ranges were given other attributes, which may split the mapping in the kernel
+}
+
+// NewMapping maps size bytes at offset in the file fd, with the protection
+// prot and the flags of mmap(2), such as MAP_SHARED or MAP_PRIVATE|MAP_ANON
+// with fd set to -1. The mapping is placed with MappingOptions.Fixed rather
+// than MAP_FIXED. opts may be nil.
+//
+// On systems other than Linux, and on Linux when the mapping cannot be
+// moved as a whole, Resize maps fd again, so fd must remain open for as
+// long as the mapping may be resized.
+func NewMapping(fd int, offset int64, size, prot, flags int, opts *MappingOptions) (*Mapping, error) {
+	var o MappingOptions
+	if opts != nil {
+		o = *opts
+	}
+	pagesize := Getpagesize()
+	if size <= 0 || flags&MAP_FIXED != 0 || o.GuardPages < 0 ||
+		o.Fixed && (o.Addr == 0 || o.Addr%uintptr(pagesize) != 0) {
+		return nil, EINVAL
+	}
+	m := &Mapping{
+		guard:  o.GuardPages * pagesize,
+		fd:     fd,
+		offset: offset,
+		prot:   prot,
+		flags:  flags,
+		huge:   o.HugePages,
+	}
+	mem, err := m.mapRegion(o.Addr, o.Fixed, size, prot)
+	if err != nil {
+		return nil, err
+	}
+	m.mem, m.size = mem, size
+	return m, nil
+}
+
+// Bytes returns the contents of the mapping. The slice is only valid until
+// the next call to Resize or Unmap.
+func (m *Mapping) Bytes() []byte {
+	return m.mem[:m.size:m.size]
+}
+
+// Resize changes the size of the mapping to size bytes, keeping its
+// contents up to the smaller of the two sizes. Shrinking unmaps the end of
+// the mapping in place; growing may move it.
+//
+// On Linux, the mapping grows with mremap(2). Otherwise, or if parts of the
+// mapping have different protections or locks, fd is mapped again: the
+// contents of private and anonymous mappings are then copied, which loses
+// the sharing of anonymous shared mappings with child processes, and the
+// protections, locks and advice applied to ranges of the mapping are lost.
+// If copying fails, the protections applied to ranges with Protect may
+// have been reset to that of the mapping.
+func (m *Mapping) Resize(size int) error {
+	if m.mem == nil || size <= 0 {
+		return EINVAL
+	}
+	length := pageRound(size)
+	switch {
+	case length == len(m.mem):
+	case length < len(m.mem):
+		if err := m.shrink(length); err != nil {
+			return err
+		}
+		m.mem = m.mem[:length:length]
+	default:
+		mem, err := m.remap(size)
+		if err != nil {
+			return err
+		}
+		m.mem = mem
+	}
+	m.size = size
+	return nil
+}
+
+// shrink unmaps the end of m beyond length bytes, moving the trailing
+// guard region. The start of the end is first replaced with the new guard
+// region, so that the address range is never left unreserved.
+func (m *Mapping) shrink(length int) error {
+	end := uintptr(unsafe.Pointer(&m.mem[0])) + uintptr(length)
+	guard := uintptr(m.guard)
+	if guard > 0 {
+		if _, err := mmap(end, guard, PROT_NONE, MAP_PRIVATE|MAP_ANON|MAP_FIXED, -1, 0); err != nil {
+			return err
+		}
+	}
+	return munmap(end+guard, uintptr(len(m.mem)-length))
+}
+
+// Advise gives the advice, one of the MADV_* constants, for a range of
+// the mapping with madvise(2).
+func (m *Mapping) Advise(off, n, advice int) error {
+	b, err := m.pages(off, n)
+	if err != nil || len(b) == 0 {
+		return err
+	}
+	m.split = true
+	return Madvise(b, advice)
+}
+
+// Protect sets the protection of a range of the mapping with mprotect(2).
+func (m *Mapping) Protect(off, n, prot int) error {
+	b, err := m.pages(off, n)
+	if err != nil || len(b) == 0 {
+		return err
+	}
+	m.rangeProt, m.split = true, true
+	return Mprotect(b, prot)
+}
+
+// Lock locks a range of the mapping in memory with mlock(2).
+func (m *Mapping) Lock(off, n int) error {
+	b, err := m.pages(off, n)
+	if err != nil || len(b) == 0 {
+		return err
+	}
+	m.split = true
+	return Mlock(b)
+}
+
+// Unlock unlocks a range of the mapping with munlock(2).
+func (m *Mapping) Unlock(off, n int) error {
+	b, err := m.pages(off, n)
+	if err != nil || len(b) == 0 {
+		return err
+	}
+	return Munlock(b)
+}
+
+// Sync flushes a range of the mapping to its file with msync(2). flags is
+// MS_SYNC or MS_ASYNC, possibly with MS_INVALIDATE.
+func (m *Mapping) Sync(off, n, flags int) error {
+	b, err := m.pages(off, n)
+	if err != nil || len(b) == 0 {
+		return err
+	}
+	return Msync(b, flags)
+}
+
+// Unmap unmaps the mapping and its guard pages.
+func (m *Mapping) Unmap() error {
+	if m.mem == nil {
+		return EINVAL
+	}
+	if err := munmap(m.base(), uintptr(len(m.mem)+2*m.guard)); err != nil {
+		return err
+	}
+	m.mem, m.size = nil, 0
+	return nil
+}
+
+// base returns the address of the region reserved for the mapping, which
+// starts with the leading guard pages.
+func (m *Mapping) base() uintptr {
+	return uintptr(unsafe.Pointer(&m.mem[0])) - uintptr(m.guard)
+}
+
+// pages returns the pages holding the n bytes at off.
+func (m *Mapping) pages(off, n int) ([]byte, error) {
+	if off < 0 || n < 0 || off > m.size || n > m.size-off {
+		return nil, EINVAL
+	}
+	if n == 0 {
+		return nil, nil
+	}
+	start := off &^ (Getpagesize() - 1)
+	return m.mem[start:pageRound(off+n)], nil
+}
+
+// mapRegion maps size bytes of the file of m at addr with protection prot,
+// between the guard regions of m.
+func (m *Mapping) mapRegion(addr uintptr, fixed bool, size, prot int) ([]byte, error) {
+	length := uintptr(pageRound(size))
+	guard := uintptr(m.guard)
+	if addr != 0 {
+		addr -= guard
+	}
+	var p uintptr
+	var err error
+	if guard == 0 {
+		p, err = mmapAt(addr, fixed, length, prot, m.flags, m.fd, m.offset)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Reserve the whole region, then map the file over its middle.
+		base, err := mmapAt(addr, fixed, length+2*guard, PROT_NONE, MAP_PRIVATE|MAP_ANON, -1, 0)
+		if err != nil {
+			return nil, err
+		}
+		p, err = mmap(base+guard, length, prot, m.flags|MAP_FIXED, m.fd, m.offset)
+		if err != nil {
+			munmap(base, length+2*guard)
+			return nil, err
+		}
+	}
+	mem := unsafe.Slice((*byte)(unsafe.Pointer(p)), length)
+	if m.huge {
+		if err := adviseHugePages(mem); err != nil {
+			munmap(p-guard, length+2*guard)
+			return nil, err
+		}
+	}
+	return mem, nil
+}
+
+// mmapAt calls mmap(2), placing the mapping exactly at addr if fixed is
+// set. Kernels that do not support MAP_FIXED_NOREPLACE take addr as a hint,
+// so the address is checked in any case.
+func mmapAt(addr uintptr, fixed bool, length uintptr, prot, flags, fd int, offset int64) (uintptr, error) {
+	if fixed {
+		flags |= mapFixedNoreplace
+	}
+	p, err := mmap(addr, length, prot, flags, fd, offset)
+	if err != nil {
+		return 0, err
+	}
+	if fixed && p != addr {
+		munmap(p, length)
+		return 0, EEXIST
+	}
+	return p, nil
+}
+
+// copyRemap resizes m by mapping its file again, copying the contents of
+// private and anonymous mappings, and unmapping the old region. The old
+// region is left usable if that fails.
+func (m *Mapping) copyRemap(size int) ([]byte, error) {
+	copied := m.flags&MAP_SHARED == 0 || m.flags&MAP_ANON != 0
+	prot := m.prot
+	if copied {
+		prot |= PROT_WRITE
+	}
+	mem, err := m.mapRegion(0, false, size, prot)
+	if err != nil {
+		return nil, err
+	}
+	if copied {
+		err = m.copyTo(mem, size)
+		if prot != m.prot && err == nil {
+			err = Mprotect(mem, m.prot)
+		}
+		if err != nil {
+			munmap(uintptr(unsafe.Pointer(&mem[0]))-uintptr(m.guard), uintptr(len(mem)+2*m.guard))
+			return nil, err
+		}
+	}
+	munmap(m.base(), uintptr(len(m.mem)+2*m.guard))
+	m.rangeProt, m.split = false, false
+	return mem, nil
+}
+
+// copyTo copies the contents of m, up to size bytes, to mem. The old
+// region may lack read access, in which case it is made readable for the
+// copy without dropping any access it has otherwise.
+func (m *Mapping) copyTo(mem []byte, size int) error {
+	if m.prot&PROT_READ == 0 || m.rangeProt {
+		if err := Mprotect(m.mem, m.prot|PROT_READ); err != nil {
+			Mprotect(m.mem, m.prot)
+			return err
+		}
+		// The ranges given other protections by Protect are now reset.
+		m.rangeProt = false
+		defer Mprotect(m.mem, m.prot)
+	}
+	copy(mem, m.mem[:min(m.size, size)])
+	return nil
+}
+
+// pageRound rounds n up to a multiple of the page size.
+func pageRound(n int) int {
+	pagesize := Getpagesize()
+	return (n + pagesize - 1) &^ (pagesize - 1)
+}
@@ -0,0 +1,19 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build aix || darwin || dragonfly || freebsd || netbsd || openbsd || solaris
+
+package unix
+
+// mapFixedNoreplace is 0, so that fixed mappings are placed with a hint
+// whose result is checked.
+const mapFixedNoreplace = 0
+
+func adviseHugePages(b []byte) error {
+	return nil
+}
+
+func (m *Mapping) remap(size int) ([]byte, error) {
+	return m.copyRemap(size)
+}
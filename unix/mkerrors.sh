@@ -231,6 +231,7 @@ struct ltchars {
 #include <linux/ethtool_netlink.h>
 #include <linux/falloc.h>
 #include <linux/fanotify.h>
+#include <linux/fiemap.h>
 #include <linux/fib_rules.h>
 #include <linux/filter.h>
 #include <linux/fs.h>
@@ -267,6 +268,8 @@ struct ltchars {
 #include <linux/nfc.h>
 #include <linux/nsfs.h>
 #include <linux/perf_event.h>
+#include <linux/posix_acl.h>
+#include <linux/posix_acl_xattr.h>
 #include <linux/pps.h>
 #include <linux/ptp_clock.h>
 #include <linux/ptrace.h>
@@ -285,6 +288,7 @@ struct ltchars {
 #include <linux/wait.h>
 #include <linux/watchdog.h>
 #include <linux/wireguard.h>
+#include <linux/xattr.h>
 
 #include <mtd/ubi-user.h>
 #include <mtd/mtd-user.h>
@@ -547,6 +551,7 @@ ccflags="$@"
 		$2 ~ /^(AF|SOCK|SO|SOL|IPPROTO|IP|IPV6|TCP|MCAST|EVFILT|NOTE|SHUT|PROT|MAP|MREMAP|MFD|T?PACKET|MSG|SCM|MCL|DT|MADV|PR|LOCAL|TCPOPT|UDP)_/ ||
 		$2 ~ /^NFC_(GENL|PROTO|COMM|RF|SE|DIRECTION|LLCP|SOCKPROTO)_/ ||
 		$2 ~ /^NFC_.*_(MAX)?SIZE$/ ||
+		$2 ~ /^PKEY_/ ||
 		$2 ~ /^PTP_/ ||
 		$2 ~ /^RAW_PAYLOAD_/ ||
 		$2 ~ /^[US]F_/ ||
@@ -598,7 +603,11 @@ ccflags="$@"
 		$2 ~ /^CPUSTATES$/ ||
 		$2 ~ /^CTLIOCGINFO$/ ||
 		$2 ~ /^ALG_/ ||
+		$2 ~ /^ACL_(USER|GROUP|MASK|OTHER|READ|WRITE|EXECUTE|TYPE)/ ||
+		$2 ~ /^POSIX_ACL_XATTR_VERSION$/ ||
 		$2 ~ /^FI(CLONE|DEDUPERANGE)/ ||
+		$2 ~ /^FIEMAP_/ ||
+		$2 ~ /^FS_IOC_FIEMAP$/ ||
 		$2 ~ /^FS_(POLICY_FLAGS|KEY_DESC|ENCRYPTION_MODE|[A-Z0-9_]+_KEY_SIZE)/ ||
 		$2 ~ /^FS_IOC_.*(ENCRYPTION|VERITY|[GS]ETFLAGS)/ ||
 		$2 ~ /^FS_VERITY_/ ||
@@ -626,6 +635,7 @@ ccflags="$@"
 		$2 ~ /^UBI_IOC[A-Z]/ ||
 		$2 ~ /^UTIME_/ ||
 		$2 ~ /^XATTR_(CREATE|REPLACE|NO(DEFAULT|FOLLOW|SECURITY)|SHOWCOMPRESSION)/ ||
+		$2 ~ /^XATTR_NAME_POSIX_ACL_(ACCESS|DEFAULT)$/ ||
 		$2 ~ /^ATTR_(BIT_MAP_COUNT|(CMN|VOL|FILE)_)/ ||
 		$2 ~ /^FSOPT_/ ||
 		$2 ~ /^WDIO[CFS]_/ ||
@@ -7,8 +7,12 @@
 package unix_test
 
 import (
+	"bytes"
+	"os"
+	"path/filepath"
 	"runtime"
 	"testing"
+	"unsafe"
 
 	"golang.org/x/sys/unix"
 )
@@ -68,3 +72,132 @@ func TestMmapPtr(t *testing.T) {
 		t.Fatalf("MunmapPtr: %v", err)
 	}
 }
+
+func TestMapping(t *testing.T) {
+	pagesize := unix.Getpagesize()
+	m, err := unix.NewMapping(-1, 0, pagesize+1, unix.PROT_READ|unix.PROT_WRITE,
+		unix.MAP_ANON|unix.MAP_PRIVATE, &unix.MappingOptions{GuardPages: 1})
+	if err != nil {
+		t.Fatalf("NewMapping: %v", err)
+	}
+	defer m.Unmap()
+	b := m.Bytes()
+	if len(b) != pagesize+1 {
+		t.Fatalf("len(Bytes()) = %d, want %d", len(b), pagesize+1)
+	}
+	for i := range b {
+		b[i] = byte(i)
+	}
+	want := bytes.Clone(b)
+
+	// The guard pages are taken, so a fixed mapping there must fail.
+	guard := uintptr(unsafe.Pointer(&b[0])) - uintptr(pagesize)
+	if _, err := unix.NewMapping(-1, 0, pagesize, unix.PROT_READ, unix.MAP_ANON|unix.MAP_PRIVATE,
+		&unix.MappingOptions{Addr: guard, Fixed: true}); err != unix.EEXIST {
+		t.Errorf("NewMapping over guard page: got %v, want EEXIST", err)
+	}
+
+	if err := m.Resize(4 * pagesize); err != nil {
+		t.Fatalf("Resize: %v", err)
+	}
+	if b := m.Bytes(); len(b) != 4*pagesize || !bytes.Equal(b[:len(want)], want) {
+		t.Fatalf("contents not preserved by Resize")
+	}
+
+	// Shrinking moves the trailing guard page.
+	if err := m.Resize(pagesize + 1); err != nil {
+		t.Fatalf("Resize to shrink: %v", err)
+	}
+	b = m.Bytes()
+	if !bytes.Equal(b, want) {
+		t.Fatalf("contents not preserved by shrinking")
+	}
+	end := uintptr(unsafe.Pointer(&b[0])) + uintptr(2*pagesize)
+	if _, err := unix.NewMapping(-1, 0, pagesize, unix.PROT_READ, unix.MAP_ANON|unix.MAP_PRIVATE,
+		&unix.MappingOptions{Addr: end, Fixed: true}); err != unix.EEXIST {
+		t.Errorf("NewMapping over trailing guard page after shrinking: got %v, want EEXIST", err)
+	}
+
+	// Protecting a range splits the mapping, which mremap cannot move, so
+	// it is copied instead.
+	if err := m.Protect(0, pagesize, unix.PROT_READ); err != nil {
+		t.Fatalf("Protect: %v", err)
+	}
+	if err := m.Resize(3 * pagesize); err != nil {
+		t.Fatalf("Resize after Protect: %v", err)
+	}
+	b = m.Bytes()
+	if !bytes.Equal(b[:len(want)], want) {
+		t.Fatalf("contents not preserved by Resize after Protect")
+	}
+	b[0] = 1 // Writable again.
+	if err := m.Resize(pagesize + 1); err != nil {
+		t.Fatalf("Resize: %v", err)
+	}
+	b = m.Bytes()
+	b[0] = 0
+	if !bytes.Equal(b, want) {
+		t.Fatalf("contents not preserved by Resize")
+	}
+
+	if err := m.Advise(1, pagesize, unix.MADV_WILLNEED); err != nil {
+		t.Errorf("Advise: %v", err)
+	}
+	if err := m.Lock(0, pagesize); err == nil {
+		if err := m.Unlock(0, pagesize); err != nil {
+			t.Errorf("Unlock: %v", err)
+		}
+	} else if err != unix.EPERM && err != unix.ENOMEM && err != unix.EAGAIN {
+		t.Errorf("Lock: %v", err)
+	}
+	if err := m.Advise(0, 2*pagesize, unix.MADV_WILLNEED); err != unix.EINVAL {
+		t.Errorf("Advise out of range: got %v, want EINVAL", err)
+	}
+
+	if err := m.Unmap(); err != nil {
+		t.Fatalf("Unmap: %v", err)
+	}
+	if err := m.Unmap(); err != unix.EINVAL {
+		t.Errorf("second Unmap: got %v, want EINVAL", err)
+	}
+}
+
+func TestMappingFile(t *testing.T) {
+	if runtime.GOOS == "aix" {
+		t.Skip("msync returns invalid argument for AIX")
+	}
+	pagesize := unix.Getpagesize()
+	f, err := os.Create(filepath.Join(t.TempDir(), "mapping"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := f.Truncate(int64(2 * pagesize)); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := unix.NewMapping(int(f.Fd()), 0, pagesize, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_SHARED, nil)
+	if err != nil {
+		t.Fatalf("NewMapping: %v", err)
+	}
+	defer m.Unmap()
+	copy(m.Bytes(), "hello")
+	if err := m.Resize(2 * pagesize); err != nil {
+		t.Fatalf("Resize: %v", err)
+	}
+	b := m.Bytes()
+	copy(b[pagesize:], "world")
+	if err := m.Sync(0, len(b), unix.MS_SYNC); err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+
+	got := make([]byte, 5)
+	for off, want := range map[int64]string{0: "hello", int64(pagesize): "world"} {
+		if _, err := f.ReadAt(got, off); err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != want {
+			t.Errorf("file at %d = %q, want %q", off, got, want)
+		}
+	}
+}
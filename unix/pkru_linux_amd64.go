@@ -0,0 +1,74 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build gc
+
+package unix
+
+import "golang.org/x/sys/cpu"
+
+// rdpkru and wrpkru are implemented in pkru_linux_amd64.s.
+func rdpkru() uint32
+func wrpkru(pkru uint32)
+
+// The protection keys usable on amd64 and the access rights bits of each.
+const (
+	pkeyCount      = 16
+	pkeyRightsBits = 2
+)
+
+// ReadPKRU returns the protection key rights register of the calling thread.
+// It returns ENOSYS if the CPU or the kernel does not support protection
+// keys.
+func ReadPKRU() (uint32, error) {
+	if !cpu.X86.HasPKU {
+		return 0, ENOSYS
+	}
+	return rdpkru(), nil
+}
+
+// WritePKRU sets the protection key rights register of the calling thread
+// to pkru. It returns ENOSYS if the CPU or the kernel does not support
+// protection keys.
+//
+// The register is per thread, so the calling goroutine should be locked to
+// its thread with runtime.LockOSThread while it relies on the new rights.
+func WritePKRU(pkru uint32) error {
+	if !cpu.X86.HasPKU {
+		return ENOSYS
+	}
+	wrpkru(pkru)
+	return nil
+}
+
+// PkeyGet returns the access rights of the calling thread for the
+// protection key pkey, as a combination of PKEY_DISABLE_ACCESS and
+// PKEY_DISABLE_WRITE.
+func PkeyGet(pkey int) (int, error) {
+	if pkey < 0 || pkey >= pkeyCount {
+		return 0, EINVAL
+	}
+	pkru, err := ReadPKRU()
+	if err != nil {
+		return 0, err
+	}
+	return int(pkru>>(pkey*pkeyRightsBits)) & PKEY_ACCESS_MASK, nil
+}
+
+// PkeySet sets the access rights of the calling thread for the protection
+// key pkey to rights, a combination of PKEY_DISABLE_ACCESS and
+// PKEY_DISABLE_WRITE. Memory tagged with pkey using PkeyMprotect can then
+// only be accessed as rights allows, on this thread only; see WritePKRU.
+func PkeySet(pkey int, rights int) error {
+	if pkey < 0 || pkey >= pkeyCount || rights&^PKEY_ACCESS_MASK != 0 {
+		return EINVAL
+	}
+	pkru, err := ReadPKRU()
+	if err != nil {
+		return err
+	}
+	shift := pkey * pkeyRightsBits
+	pkru = pkru&^(PKEY_ACCESS_MASK<<shift) | uint32(rights)<<shift
+	return WritePKRU(pkru)
+}
@@ -0,0 +1,22 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build gc
+
+#include "textflag.h"
+
+// func rdpkru() uint32
+TEXT ·rdpkru(SB), NOSPLIT, $0-4
+	MOVL $0, CX
+	RDPKRU
+	MOVL AX, ret+0(FP)
+	RET
+
+// func wrpkru(pkru uint32)
+TEXT ·wrpkru(SB), NOSPLIT, $0-4
+	MOVL pkru+0(FP), AX
+	MOVL $0, CX
+	MOVL $0, DX
+	WRPKRU
+	RET
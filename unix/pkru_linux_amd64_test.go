@@ -0,0 +1,75 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build gc
+
+package unix_test
+
+import (
+	"runtime"
+	"runtime/debug"
+	"testing"
+
+	"golang.org/x/sys/cpu"
+	"golang.org/x/sys/unix"
+)
+
+func TestPkeySet(t *testing.T) {
+	if !cpu.X86.HasPKU {
+		if _, err := unix.ReadPKRU(); err != unix.ENOSYS {
+			t.Errorf("ReadPKRU: got %v, want ENOSYS", err)
+		}
+		t.Skip("protection keys not supported")
+	}
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	pkey, err := unix.PkeyAlloc(0, 0)
+	if err == unix.ENOSPC || err == unix.EINVAL || err == unix.ENOSYS {
+		t.Skipf("PkeyAlloc: %v", err)
+	} else if err != nil {
+		t.Fatalf("PkeyAlloc: %v", err)
+	}
+	defer unix.PkeyFree(pkey)
+
+	b, err := unix.Mmap(-1, 0, unix.Getpagesize(), unix.PROT_READ|unix.PROT_WRITE, unix.MAP_ANON|unix.MAP_PRIVATE)
+	if err != nil {
+		t.Fatalf("Mmap: %v", err)
+	}
+	defer unix.Munmap(b)
+	if err := unix.PkeyMprotect(b, unix.PROT_READ|unix.PROT_WRITE, pkey); err != nil {
+		t.Fatalf("PkeyMprotect: %v", err)
+	}
+	b[0] = 1
+
+	if err := unix.PkeySet(pkey, unix.PKEY_DISABLE_WRITE); err != nil {
+		t.Fatalf("PkeySet: %v", err)
+	}
+	defer unix.PkeySet(pkey, 0)
+	if rights, err := unix.PkeyGet(pkey); err != nil || rights != unix.PKEY_DISABLE_WRITE {
+		t.Fatalf("PkeyGet: got %#x, %v; want %#x", rights, err, unix.PKEY_DISABLE_WRITE)
+	}
+	if b[0] != 1 {
+		t.Errorf("read through write-disabled key: got %d, want 1", b[0])
+	}
+
+	faulted := func() (faulted bool) {
+		defer debug.SetPanicOnFault(debug.SetPanicOnFault(true))
+		defer func() { faulted = recover() != nil }()
+		b[0] = 2
+		return false
+	}()
+	if !faulted {
+		t.Errorf("write through write-disabled key did not fault")
+	}
+
+	if err := unix.PkeySet(pkey, 0); err != nil {
+		t.Fatalf("PkeySet: %v", err)
+	}
+	b[0] = 3
+
+	if err := unix.PkeySet(16, 0); err != unix.EINVAL {
+		t.Errorf("PkeySet(16): got %v, want EINVAL", err)
+	}
+}
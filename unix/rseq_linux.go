@@ -0,0 +1,119 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix
+
+import (
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// Size of struct rseq up to and including the node_id and mm_cid fields.
+const (
+	rseqNodeIDSize = 24
+	rseqMmCIDSize  = 28
+)
+
+var (
+	rseqMu         sync.Mutex
+	rseqRegistered = map[*Rseq]bool{}
+)
+
+// rseqFeatureSize returns the size of the part of struct rseq populated by
+// the kernel, which is 20 bytes on kernels that do not report it.
+var rseqFeatureSize = sync.OnceValue(func() int {
+	size := 20
+	auxv, err := Auxv()
+	if err != nil {
+		return size
+	}
+	for _, kv := range auxv {
+		if kv[0] == AT_RSEQ_FEATURE_SIZE {
+			size = int(kv[1])
+		}
+	}
+	return size
+})
+
+// RseqRegister registers a restartable sequences area for the calling thread
+// using the abort handler signature sig and returns it. The kernel keeps the
+// CPU fields of the area up to date as the thread migrates. The calling
+// goroutine must stay locked to its thread with runtime.LockOSThread until
+// the area is released with RseqUnregister, which must be called on the same
+// thread.
+//
+// A thread can only have one registered area. If the C library, such as
+// glibc 2.35 and later in programs using cgo, or another component has
+// already registered one for the thread, RseqRegister fails with EBUSY and
+// the existing area is left untouched. Setting the GLIBC_TUNABLES environment
+// variable to glibc.pthread.rseq=0 stops glibc from registering its own.
+func RseqRegister(sig uint32) (*Rseq, error) {
+	// The area must be aligned to its size, which is more than the
+	// alignment Go guarantees, so carve it out of a larger buffer.
+	buf := make([]byte, 2*SizeofRseq)
+	off := (SizeofRseq - uintptr(unsafe.Pointer(&buf[0]))%SizeofRseq) % SizeofRseq
+	r := (*Rseq)(unsafe.Pointer(&buf[off]))
+	r.Cpu_id = RSEQ_CPU_ID_UNINITIALIZED & 0xffffffff
+
+	switch err := rseq(r, SizeofRseq, 0, sig); err {
+	case nil:
+	case EINVAL:
+		// The area is correctly sized and aligned, so the kernel only
+		// rejects it because the thread has registered a different one.
+		return nil, EBUSY
+	default:
+		return nil, err
+	}
+
+	// Keep the area alive for as long as the kernel may write to it.
+	rseqMu.Lock()
+	rseqRegistered[r] = true
+	rseqMu.Unlock()
+	return r, nil
+}
+
+// RseqUnregister unregisters the area r, which must have been returned by
+// RseqRegister on the calling thread with the same signature sig.
+func RseqUnregister(r *Rseq, sig uint32) error {
+	rseqMu.Lock()
+	defer rseqMu.Unlock()
+	if !rseqRegistered[r] {
+		return EINVAL
+	}
+	if err := rseq(r, SizeofRseq, RSEQ_FLAG_UNREGISTER, sig); err != nil {
+		return err
+	}
+	delete(rseqRegistered, r)
+	return nil
+}
+
+// CPU returns the CPU on which the thread that registered r is running, or
+// RSEQ_CPU_ID_UNINITIALIZED if r is not registered. It should only be called
+// on that thread.
+func (r *Rseq) CPU() int {
+	return int(int32(atomic.LoadUint32(&r.Cpu_id)))
+}
+
+// Node returns the NUMA node on which the thread that registered r is
+// running, or -1 if the kernel does not provide it. It should only be called
+// on that thread.
+func (r *Rseq) Node() int {
+	if rseqFeatureSize() < rseqNodeIDSize {
+		return -1
+	}
+	return int(atomic.LoadUint32(&r.Node_id))
+}
+
+// MmCID returns the memory map concurrency ID of the thread that registered
+// r, or -1 if the kernel does not provide it. The ID is unique among the
+// threads of the process running concurrently and stays close to 0, which
+// makes it a compact index for per-CPU data. It should only be called on
+// that thread.
+func (r *Rseq) MmCID() int {
+	if rseqFeatureSize() < rseqMmCIDSize {
+		return -1
+	}
+	return int(atomic.LoadUint32(&r.Mm_cid))
+}
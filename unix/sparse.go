@@ -0,0 +1,96 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build darwin || freebsd || linux
+
+package unix
+
+// FileRegion is a region of a file returned by a FileRegionReader.
+type FileRegion struct {
+	Offset int64
+	Length int64
+	Hole   bool
+}
+
+// FileRegionReader iterates over the data and hole regions of a possibly
+// sparse file using Seek with SEEK_DATA and SEEK_HOLE. The regions
+// alternate and cover the file from the starting offset to the end of the
+// file as it was sized when the reader was created. On file systems that
+// do not support SEEK_DATA, the file is reported as a single data region.
+//
+// The reader moves the file offset of fd.
+type FileRegionReader struct {
+	fd     int
+	off    int64
+	size   int64
+	region FileRegion
+	err    error
+}
+
+// NewFileRegionReader returns a FileRegionReader that reads the regions of
+// the file fd from offset off.
+func NewFileRegionReader(fd int, off int64) (*FileRegionReader, error) {
+	var st Stat_t
+	if err := Fstat(fd, &st); err != nil {
+		return nil, err
+	}
+	return &FileRegionReader{fd: fd, off: off, size: int64(st.Size)}, nil
+}
+
+// Next advances to the next region, which is then available through
+// Region. It returns false at the end of the file or on error; Err reports
+// which.
+func (r *FileRegionReader) Next() bool {
+	if r.err != nil || r.off >= r.size {
+		return false
+	}
+	data, err := Seek(r.fd, r.off, SEEK_DATA)
+	switch err {
+	case nil:
+	case ENXIO:
+		// No data past r.off: the rest of the file is a hole.
+		data = r.size
+	case EINVAL:
+		// SEEK_DATA is not supported: treat the rest of the file as data.
+		data = r.off
+	default:
+		r.err = err
+		return false
+	}
+	if data > r.off {
+		r.set(min(data, r.size), true)
+		return true
+	}
+
+	hole, err := Seek(r.fd, r.off, SEEK_HOLE)
+	switch err {
+	case nil:
+	case ENXIO, EINVAL:
+		hole = r.size
+	default:
+		r.err = err
+		return false
+	}
+	if hole <= r.off {
+		// The file changed underfoot; don't return an empty region.
+		hole = r.size
+	}
+	r.set(min(hole, r.size), false)
+	return true
+}
+
+func (r *FileRegionReader) set(end int64, hole bool) {
+	r.region = FileRegion{Offset: r.off, Length: end - r.off, Hole: hole}
+	r.off = end
+}
+
+// Region returns the region read by the most recent call to Next.
+func (r *FileRegionReader) Region() FileRegion {
+	return r.region
+}
+
+// Err returns the first error encountered by Next.
+func (r *FileRegionReader) Err() error {
+	return r.err
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build darwin || freebsd || linux
+
+package unix_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestFileRegionReader(t *testing.T) {
+	const size = 16 << 20
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "sparse"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	data := make([]byte, 1<<20)
+	for i := range data {
+		data[i] = 1
+	}
+	if _, err := f.WriteAt(data, 4<<20); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Truncate(size); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := unix.NewFileRegionReader(int(f.Fd()), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var off, dataLen int64
+	for r.Next() {
+		reg := r.Region()
+		if reg.Offset != off || reg.Length <= 0 {
+			t.Fatalf("unexpected region %+v at offset %d", reg, off)
+		}
+		if !reg.Hole {
+			dataLen += reg.Length
+		}
+		off += reg.Length
+	}
+	if err := r.Err(); err != nil {
+		t.Fatalf("Next: %v", err)
+	}
+	if off != size {
+		t.Errorf("regions cover %d bytes, want %d", off, size)
+	}
+	// File systems may round data regions up to their block size or not
+	// support holes at all, but the written data must be covered.
+	if dataLen < int64(len(data)) {
+		t.Errorf("data regions cover %d bytes, want at least %d", dataLen, len(data))
+	}
+}
@@ -348,6 +348,15 @@ func direntNamlen(buf []byte) (uint64, bool) {
 	return reclen - uint64(unsafe.Offsetof(Dirent{}.Name)), true
 }
 
+func direntType(buf []byte) (uint64, bool) {
+	// d_type is not available.
+	return 0, true
+}
+
+func direntOff(buf []byte) (uint64, bool) {
+	return readInt(buf, unsafe.Offsetof(Dirent{}.Offset), unsafe.Sizeof(Dirent{}.Offset))
+}
+
 //sys	getdirent(fd int, buf []byte) (n int, err error)
 
 func Getdents(fd int, buf []byte) (n int, err error) {
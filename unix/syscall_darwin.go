@@ -227,6 +227,16 @@ func direntNamlen(buf []byte) (uint64, bool) {
 	return readInt(buf, unsafe.Offsetof(Dirent{}.Namlen), unsafe.Sizeof(Dirent{}.Namlen))
 }
 
+func direntType(buf []byte) (uint64, bool) {
+	return readInt(buf, unsafe.Offsetof(Dirent{}.Type), unsafe.Sizeof(Dirent{}.Type))
+}
+
+func direntOff(buf []byte) (uint64, bool) {
+	// d_seekoff is not meaningful to lseek with the fdopendir-based
+	// Getdirentries emulation, which keeps an entry count as its offset.
+	return 0, true
+}
+
 func PtraceAttach(pid int) (err error) { return ptrace(PT_ATTACH, pid, 0, 0) }
 func PtraceDetach(pid int) (err error) { return ptrace(PT_DETACH, pid, 0, 0) }
 func PtraceDenyAttach() (err error)    { return ptrace(PT_DENY_ATTACH, 0, 0, 0) }
@@ -610,6 +620,14 @@ func Connectx(fd int, srcIf uint32, srcAddr, dstAddr Sockaddr, associd SaeAssocI
 //sys	shmdt(addr uintptr) (err error)
 //sys	shmget(key int, size int, flag int) (id int, err error)
 
+//sys	semget(key int, nsems int, flag int) (id int, err error)
+//sys	semop(id int, sops []Sembuf) (err error)
+
+//sys	msgget(key int, flag int) (id int, err error)
+//sys	msgctl(id int, cmd int, buf *SysvMsqDesc) (result int, err error)
+//sys	msgsnd(id int, msgp unsafe.Pointer, size uintptr, flag int) (err error)
+//sys	msgrcv(id int, msgp unsafe.Pointer, size uintptr, typ int, flag int) (n int, err error)
+
 /*
  * Exposed directly
  */
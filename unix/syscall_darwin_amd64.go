@@ -48,3 +48,9 @@ func Syscall9(num, a1, a2, a3, a4, a5, a6, a7, a8, a9 uintptr) (r1, r2 uintptr,
 //sys	ptrace1(request int, pid int, addr uintptr, data uintptr) (err error) = SYS_ptrace
 //sys	Stat(path string, stat *Stat_t) (err error) = SYS_STAT64
 //sys	Statfs(path string, stat *Statfs_t) (err error) = SYS_STATFS64
+
+// semctl is variadic in libc. On amd64 its variadic argument is passed in a
+// register like the others; see sysvsem_darwin_arm64.go for arm64.
+
+//sys	semctl(id int, num int, cmd int, arg uintptr) (result int, err error)
+//sys	semctlPtr(id int, num int, cmd int, arg unsafe.Pointer) (result int, err error) = SYS_SEMCTL
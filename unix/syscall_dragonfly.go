@@ -95,6 +95,15 @@ func direntNamlen(buf []byte) (uint64, bool) {
 	return readInt(buf, unsafe.Offsetof(Dirent{}.Namlen), unsafe.Sizeof(Dirent{}.Namlen))
 }
 
+func direntType(buf []byte) (uint64, bool) {
+	return readInt(buf, unsafe.Offsetof(Dirent{}.Type), unsafe.Sizeof(Dirent{}.Type))
+}
+
+func direntOff(buf []byte) (uint64, bool) {
+	// d_off is not available.
+	return 0, true
+}
+
 //sysnb	pipe() (r int, w int, err error)
 
 func Pipe(p []int) (err error) {
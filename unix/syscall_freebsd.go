@@ -86,6 +86,14 @@ func direntNamlen(buf []byte) (uint64, bool) {
 	return readInt(buf, unsafe.Offsetof(Dirent{}.Namlen), unsafe.Sizeof(Dirent{}.Namlen))
 }
 
+func direntType(buf []byte) (uint64, bool) {
+	return readInt(buf, unsafe.Offsetof(Dirent{}.Type), unsafe.Sizeof(Dirent{}.Type))
+}
+
+func direntOff(buf []byte) (uint64, bool) {
+	return readInt(buf, unsafe.Offsetof(Dirent{}.Off), unsafe.Sizeof(Dirent{}.Off))
+}
+
 func Pipe(p []int) (err error) {
 	return Pipe2(p, 0)
 }
@@ -7,6 +7,7 @@
 package unix
 
 import (
+	"encoding/binary"
 	"reflect"
 	"strings"
 	"testing"
@@ -949,3 +950,123 @@ func sockaddrVMToAny(in RawSockaddrVM) *RawSockaddrAny {
 	)
 	return &out
 }
+
+func TestVDSOGNUHash(t *testing.T) {
+	img, base, err := currentVDSOImage()
+	if err == ENOENT {
+		t.Skip("no vDSO")
+	} else if err != nil {
+		t.Fatal(err)
+	}
+	want, err := ParseVDSO(img, base)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Hide DT_HASH so the symbols are counted from DT_GNU_HASH.
+	b := append([]byte(nil), img...)
+	f, err := newVDSOFile(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const dtDebug = 21
+	hasGNUHash := false
+	for i := range f.phnum {
+		typ, off, _, filesz := f.prog(i)
+		if typ != _PT_DYNAMIC {
+			continue
+		}
+		entsize := f.sizes(8, 16)
+		for d := off; d+entsize <= off+filesz; d += entsize {
+			switch f.word(d) {
+			case _DT_HASH:
+				if f.is64 {
+					f.bo.PutUint64(b[d:], dtDebug)
+				} else {
+					f.bo.PutUint32(b[d:], dtDebug)
+				}
+			case _DT_GNU_HASH:
+				hasGNUHash = true
+			}
+		}
+	}
+	if !hasGNUHash {
+		t.Skip("vDSO has no DT_GNU_HASH")
+	}
+	got, err := ParseVDSO(b, base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("symbols found through DT_GNU_HASH:\n%v\nwant:\n%v", got.Symbols, want.Symbols)
+	}
+}
+
+func TestVDSOGNUHashSymbols(t *testing.T) {
+	// A GNU hash table of a 32-bit image with one bucket starting at
+	// symbol 3, whose chain ends at symbol 4. The chain hashes have the
+	// high bit set, which must not be taken for read errors.
+	var b []byte
+	for _, v := range []uint32{
+		1, 1, 1, 0, // nbuckets, symoffset, bloomSize, bloomShift
+		0,                                              // bloom filter
+		3,                                              // buckets
+		0x80000002, 0x80000004, 0x80000006, 0xffffffff, // chains of symbols 1 to 4
+	} {
+		b = binary.LittleEndian.AppendUint32(b, v)
+	}
+	f := &vdsoFile{b: b, bo: binary.LittleEndian}
+	if n := f.gnuHashSymbols(0); n != 5 {
+		t.Errorf("gnuHashSymbols = %d, want 5", n)
+	}
+	if n := (&vdsoFile{b: b[:len(b)-4], bo: binary.LittleEndian}).gnuHashSymbols(0); n != -1 {
+		t.Errorf("gnuHashSymbols on truncated table = %d, want -1", n)
+	}
+}
+
+func TestReadRandomDevice(t *testing.T) {
+	for _, flags := range []int{0, GRND_NONBLOCK, GRND_INSECURE} {
+		b := make([]byte, 1024)
+		n, err := readRandomDevice(b, flags)
+		if err == EAGAIN && flags&GRND_NONBLOCK != 0 {
+			continue
+		}
+		if err == ENOENT {
+			t.Skip("no random devices")
+		}
+		if err != nil || n != len(b) {
+			t.Fatalf("flags %#x: readRandomDevice = %d, %v; want %d, nil", flags, n, err, len(b))
+		}
+	}
+}
+
+func TestNodeSetMaxnode(t *testing.T) {
+	// get_mempolicy(2) writes ALIGN(maxnode-1, 64) bits, which must fit in
+	// the set.
+	check := func(s NodeSetDynamic, minBits int) {
+		t.Helper()
+		maxnode, err := s.maxnode()
+		if err != nil {
+			t.Fatalf("len %d: %v", len(s), err)
+		}
+		bits := maxnode - 1
+		if bits%64 != 0 || bits > uintptr(len(s))*_NCPUBITS || bits < uintptr(minBits) {
+			t.Errorf("len %d: maxnode = %d", len(s), maxnode)
+		}
+	}
+	for _, n := range []int{0, 1, 32, 33, 64, 65, 100, 1024} {
+		check(NewNodeSet(n), n)
+	}
+	// A set of an odd number of 32-bit words would lose its last word.
+	if _NCPUBITS == 32 {
+		if _, err := make(NodeSetDynamic, 3).maxnode(); err != EINVAL {
+			t.Errorf("len 3: got %v, want EINVAL", err)
+		}
+	} else {
+		check(make(NodeSetDynamic, 3), 0)
+	}
+	var s NodeSet
+	if bits := s.maxnode() - 1; bits%64 != 0 || bits > uintptr(len(s))*_NCPUBITS {
+		t.Errorf("NodeSet: maxnode = %d", s.maxnode())
+	}
+}
@@ -1810,6 +1810,14 @@ func direntNamlen(buf []byte) (uint64, bool) {
 	return reclen - uint64(unsafe.Offsetof(Dirent{}.Name)), true
 }
 
+func direntType(buf []byte) (uint64, bool) {
+	return readInt(buf, unsafe.Offsetof(Dirent{}.Type), unsafe.Sizeof(Dirent{}.Type))
+}
+
+func direntOff(buf []byte) (uint64, bool) {
+	return readInt(buf, unsafe.Offsetof(Dirent{}.Off), unsafe.Sizeof(Dirent{}.Off))
+}
+
 //sys	mount(source string, target string, fstype string, flags uintptr, data *byte) (err error)
 
 func Mount(source string, target string, fstype string, flags uintptr, data string) (err error) {
@@ -2203,6 +2211,7 @@ func Pwritev2(fd int, iovs [][]byte, offset int64, flags int) (n int, err error)
 //sys	Msync(b []byte, flags int) (err error)
 //sys	Munlock(b []byte) (err error)
 //sys	Munlockall() (err error)
+//sys	mincore(b []byte, vec *byte) (err error)
 
 const (
 	mremapFixed     = MREMAP_FIXED
@@ -2210,6 +2219,36 @@ const (
 	mremapMaymove   = MREMAP_MAYMOVE
 )
 
+// Mincore reports which pages of the memory in b, which must be page
+// aligned, are resident in memory. The least significant bit of each byte
+// in vec is set if the corresponding page is resident; vec must have room
+// for one byte per page. See mincore(2) for details.
+func Mincore(b []byte, vec []byte) error {
+	pages := (len(b) + Getpagesize() - 1) / Getpagesize()
+	if len(vec) < pages {
+		return EINVAL
+	}
+	if pages == 0 {
+		return nil
+	}
+	return mincore(b, &vec[0])
+}
+
+// MincoreResident reports for each page of the memory in b, which must be
+// page aligned, whether it is resident in memory. It is typically used on
+// a slice returned by [Mmap].
+func MincoreResident(b []byte) ([]bool, error) {
+	vec := make([]byte, (len(b)+Getpagesize()-1)/Getpagesize())
+	if err := Mincore(b, vec); err != nil {
+		return nil, err
+	}
+	resident := make([]bool, len(vec))
+	for i, v := range vec {
+		resident[i] = v&1 != 0
+	}
+	return resident, nil
+}
+
 // Vmsplice splices user pages from a slice of Iovecs into a pipe specified by fd,
 // using the specified flags.
 func Vmsplice(fd int, iovs []Iovec, flags int) (int, error) {
@@ -2428,11 +2467,97 @@ type RemoteIovec struct {
 //sys	PidfdGetfd(pidfd int, targetfd int, flags int) (fd int, err error) = SYS_PIDFD_GETFD
 //sys	PidfdSendSignal(pidfd int, sig Signal, info *Siginfo, flags int) (err error) = SYS_PIDFD_SEND_SIGNAL
 
+// ProcessMadvise gives the kernel advice about the address ranges iovs of
+// the process referred to by pidfd, which is obtained from [PidfdOpen]. The
+// ranges are given as [RemoteIovec] since they refer to the address space of
+// the target process. It returns the number of bytes advised. See
+// process_madvise(2) for details.
+//
+//sys	ProcessMadvise(pidfd int, iovs []RemoteIovec, advice int, flags uint) (n int, err error) = SYS_PROCESS_MADVISE
+
+// ProcessMrelease releases the memory of the dying process referred to by
+// pidfd. See process_mrelease(2) for details.
+//
+//sys	ProcessMrelease(pidfd int, flags uint) (err error) = SYS_PROCESS_MRELEASE
+
 //sys	shmat(id int, addr uintptr, flag int) (ret uintptr, err error)
 //sys	shmctl(id int, cmd int, buf *SysvShmDesc) (result int, err error)
 //sys	shmdt(addr uintptr) (err error)
 //sys	shmget(key int, size int, flag int) (id int, err error)
 
+//sys	semget(key int, nsems int, flag int) (id int, err error)
+//sys	semctl(id int, num int, cmd int, arg uintptr) (result int, err error)
+//sys	semctlPtr(id int, num int, cmd int, arg unsafe.Pointer) (result int, err error) = SYS_SEMCTL
+
+//sys	msgget(key int, flag int) (id int, err error)
+//sys	msgctl(id int, cmd int, buf *SysvMsqDesc) (result int, err error)
+//sys	msgsnd(id int, msgp unsafe.Pointer, size uintptr, flag int) (err error)
+//sys	msgrcv(id int, msgp unsafe.Pointer, size uintptr, typ int, flag int) (n int, err error)
+
+//sys	mqOpen(name string, flag int, mode uint32, attr *MqAttr) (mqd int, err error) = SYS_MQ_OPEN
+//sys	mqUnlink(name string) (err error) = SYS_MQ_UNLINK
+
+// MqOpen opens the POSIX message queue name, creating it with the given
+// mode and attributes if flag includes O_CREAT. If attr is nil, the queue is
+// created with the system default attributes. As with mq_open(3), the name
+// must start with a slash.
+func MqOpen(name string, flag int, mode uint32, attr *MqAttr) (mqd int, err error) {
+	name, ok := mqName(name)
+	if !ok {
+		return -1, EINVAL
+	}
+	return mqOpen(name, flag, mode, attr)
+}
+
+// MqUnlink removes the POSIX message queue name.
+func MqUnlink(name string) error {
+	name, ok := mqName(name)
+	if !ok {
+		return ENOENT
+	}
+	return mqUnlink(name)
+}
+
+// mqName strips the leading slash that the C library requires from a message
+// queue name but the kernel does not accept.
+func mqName(name string) (string, bool) {
+	if len(name) < 2 || name[0] != '/' {
+		return "", false
+	}
+	return name[1:], true
+}
+
+// MqTimedsend adds msg with priority prio to the message queue mqd. If the
+// queue is full, it blocks until there is room or until the absolute
+// CLOCK_REALTIME time timeout passes; a nil timeout blocks indefinitely.
+//
+//sys	MqTimedsend(mqd int, msg []byte, prio uint32, timeout *Timespec) (err error) = SYS_MQ_TIMEDSEND
+
+// MqTimedreceive removes the oldest message of the highest priority from the
+// message queue mqd into msg, which must be at least the queue's Msgsize
+// bytes long, and stores its priority in prio if prio is not nil. It returns
+// the length of the message. If the queue is empty, it blocks until a message
+// arrives or until the absolute CLOCK_REALTIME time timeout passes; a nil
+// timeout blocks indefinitely.
+//
+//sys	MqTimedreceive(mqd int, msg []byte, prio *uint32, timeout *Timespec) (n int, err error) = SYS_MQ_TIMEDRECEIVE
+
+// MqNotify registers the calling process to be notified as described by sev
+// when a message arrives on the empty message queue mqd, or removes the
+// registration if sev is nil. The kernel accepts SIGEV_NONE and SIGEV_SIGNAL
+// notifications, which send sev.Signo to the process with sev.Value as the
+// signal value, and SIGEV_THREAD with a netlink socket in sev.Signo.
+// Unlike timers, message queues do not support SIGEV_THREAD_ID and fail with
+// EINVAL.
+//
+//sys	MqNotify(mqd int, sev *Sigevent) (err error) = SYS_MQ_NOTIFY
+
+// MqGetsetattr retrieves the attributes of the message queue mqd into
+// oldattr if it is not nil, and then sets the O_NONBLOCK flag in attr.Flags
+// if attr is not nil. The other fields of attr are ignored.
+//
+//sys	MqGetsetattr(mqd int, attr *MqAttr, oldattr *MqAttr) (err error) = SYS_MQ_GETSETATTR
+
 //sys	getitimer(which int, currValue *Itimerval) (err error)
 //sys	setitimer(which int, newValue *Itimerval, oldValue *Itimerval) (err error)
 
@@ -2479,6 +2604,68 @@ func Setitimer(which ItimerWhich, it Itimerval) (Itimerval, error) {
 	return prev, nil
 }
 
+//sysnb	timerCreate(clockid int32, sev *Sigevent, timerid *int32) (err error) = SYS_TIMER_CREATE
+
+// TimerCreate creates a POSIX per-process timer measuring the clock clockid
+// and returns its ID. Expirations are delivered as described by sev: with
+// SIGEV_SIGNAL, sev.Signo is sent to the process; with SIGEV_THREAD_ID, it
+// is sent to the thread sev.Tid, which must belong to the calling process.
+// If sev is nil, SIGALRM is sent to the process with the timer ID as the
+// signal value.
+//
+// Since goroutines are not bound to threads, a SIGEV_THREAD_ID timer should
+// target a thread locked with runtime.LockOSThread.
+func TimerCreate(clockid int32, sev *Sigevent) (timerid int, err error) {
+	var id int32
+	if err := timerCreate(clockid, sev, &id); err != nil {
+		return -1, err
+	}
+	return int(id), nil
+}
+
+// TimerSettime arms or disarms the timer timerid as described by newValue
+// and stores the previous setting in oldValue if it is not nil. If flags
+// includes TIMER_ABSTIME, newValue.Value is an absolute time on the timer's
+// clock.
+//
+//sysnb	TimerSettime(timerid int, flags int, newValue *ItimerSpec, oldValue *ItimerSpec) (err error) = SYS_TIMER_SETTIME
+
+// TimerGettime stores the time until the next expiration of the timer
+// timerid and its interval in currValue.
+//
+//sysnb	TimerGettime(timerid int, currValue *ItimerSpec) (err error) = SYS_TIMER_GETTIME
+
+// TimerGetoverrun returns the number of expirations of the timer timerid
+// that occurred between the generation of its last signal and its delivery.
+//
+//sysnb	TimerGetoverrun(timerid int) (overrun int, err error) = SYS_TIMER_GETOVERRUN
+
+// TimerDelete deletes the timer timerid.
+//
+//sysnb	TimerDelete(timerid int) (err error) = SYS_TIMER_DELETE
+
+// Encoding of the CPU-time clock IDs, from include/linux/posix-timers.h.
+const (
+	cpuclockSched         = 2
+	cpuclockPerthreadMask = 4
+)
+
+// ProcessCPUClockID returns the ID of the clock measuring the CPU time
+// consumed by all threads of the process pid, or of the calling process if
+// pid is 0, like clock_getcpuclockid(3). It can be passed to ClockGettime or
+// TimerCreate.
+func ProcessCPUClockID(pid int) int32 {
+	return int32(^pid<<3 | cpuclockSched)
+}
+
+// ThreadCPUClockID returns the ID of the clock measuring the CPU time
+// consumed by the thread tid, or by the calling thread if tid is 0, like
+// pthread_getcpuclockid(3). It can be passed to ClockGettime or
+// TimerCreate.
+func ThreadCPUClockID(tid int) int32 {
+	return int32(^tid<<3 | cpuclockSched | cpuclockPerthreadMask)
+}
+
 //sysnb	rtSigprocmask(how int, set *Sigset_t, oldset *Sigset_t, sigsetsize uintptr) (err error) = SYS_RT_SIGPROCMASK
 
 func PthreadSigmask(how int, set, oldset *Sigset_t) error {
@@ -2572,3 +2759,174 @@ func SetMemPolicy(mode int, mask *CPUSet) error {
 func SetMemPolicyDynamic(mode int, mask CPUSetDynamic) error {
 	return setMemPolicy(mode, mask.pointer(), mask.size())
 }
+
+//sys	getMemPolicy(mode *_C_int, mask unsafe.Pointer, maxnode uintptr, addr unsafe.Pointer, flags int) (err error) = SYS_GET_MEMPOLICY
+
+// GetMemPolicy retrieves the NUMA memory policy of the calling thread, or of
+// the memory at addr if flags includes MPOL_F_ADDR, storing the policy's
+// node mask in nodes if it is not nil. If flags includes MPOL_F_NODE and
+// MPOL_F_ADDR, the returned mode is the ID of the node on which addr is
+// allocated. See get_mempolicy(2) for details.
+func GetMemPolicy(nodes *NodeSet, addr unsafe.Pointer, flags int) (mode int, err error) {
+	var m _C_int
+	var maxnode uintptr
+	if nodes != nil {
+		maxnode = nodes.maxnode()
+	}
+	err = getMemPolicy(&m, unsafe.Pointer(nodes), maxnode, addr, flags)
+	return int(m), err
+}
+
+// GetMemPolicyDynamic is like GetMemPolicy but stores the node mask in a
+// [NodeSetDynamic]. If nodes is smaller than the kernel's node mask,
+// [EINVAL] is returned.
+func GetMemPolicyDynamic(nodes NodeSetDynamic, addr unsafe.Pointer, flags int) (mode int, err error) {
+	maxnode, err := nodes.maxnode()
+	if err != nil {
+		return 0, err
+	}
+	var m _C_int
+	err = getMemPolicy(&m, nodes.pointer(), maxnode, addr, flags)
+	return int(m), err
+}
+
+//sys	mbind(addr unsafe.Pointer, length uintptr, mode int, mask unsafe.Pointer, maxnode uintptr, flags int) (err error) = SYS_MBIND
+
+// Mbind sets the NUMA memory policy mode for the memory in b, which must be
+// page aligned, to the nodes in nodes. flags is a combination of
+// MPOL_MF_STRICT, MPOL_MF_MOVE and MPOL_MF_MOVE_ALL. To bind memory
+// obtained from [MmapPtr], use [unsafe.Slice] to form b. See mbind(2) for
+// details.
+func Mbind(b []byte, mode int, nodes *NodeSet, flags int) error {
+	var maxnode uintptr
+	if nodes != nil {
+		maxnode = nodes.maxnode()
+	}
+	return mbind(unsafe.Pointer(unsafe.SliceData(b)), uintptr(len(b)), mode, unsafe.Pointer(nodes), maxnode, flags)
+}
+
+// MbindDynamic is like Mbind but takes a [NodeSetDynamic].
+func MbindDynamic(b []byte, mode int, nodes NodeSetDynamic, flags int) error {
+	maxnode, err := nodes.maxnode()
+	if err != nil {
+		return err
+	}
+	return mbind(unsafe.Pointer(unsafe.SliceData(b)), uintptr(len(b)), mode, nodes.pointer(), maxnode, flags)
+}
+
+//sys	migratePages(pid int, maxnode uintptr, oldNodes unsafe.Pointer, newNodes unsafe.Pointer) (n int, err error) = SYS_MIGRATE_PAGES
+
+// MigratePages moves the pages of the process pid that are on the nodes in
+// oldNodes to the nodes in newNodes. It returns the number of pages that
+// could not be moved. See migrate_pages(2) for details.
+func MigratePages(pid int, oldNodes, newNodes *NodeSet) (int, error) {
+	return migratePages(pid, oldNodes.maxnode(), unsafe.Pointer(oldNodes), unsafe.Pointer(newNodes))
+}
+
+// MigratePagesDynamic is like MigratePages but takes [NodeSetDynamic]
+// arguments, which must have the same size.
+func MigratePagesDynamic(pid int, oldNodes, newNodes NodeSetDynamic) (int, error) {
+	if len(oldNodes) != len(newNodes) {
+		return 0, EINVAL
+	}
+	maxnode, err := oldNodes.maxnode()
+	if err != nil {
+		return 0, err
+	}
+	return migratePages(pid, maxnode, oldNodes.pointer(), newNodes.pointer())
+}
+
+//sys	movePages(pid int, count uintptr, pages unsafe.Pointer, nodes unsafe.Pointer, status unsafe.Pointer, flags int) (err error) = SYS_MOVE_PAGES
+
+// MovePages moves the pages of the process pid containing the addresses in
+// pages to the corresponding nodes in nodes, and stores the resulting node
+// of each page, or a negative errno value, in status. If nodes is nil, the
+// pages are not moved and status reports the node on which each page
+// currently resides. flags is 0, MPOL_MF_MOVE or MPOL_MF_MOVE_ALL. See
+// move_pages(2) for details.
+func MovePages(pid int, pages []unsafe.Pointer, nodes []int32, status []int32, flags int) error {
+	if len(status) != len(pages) || (nodes != nil && len(nodes) != len(pages)) {
+		return EINVAL
+	}
+	if len(pages) == 0 {
+		return nil
+	}
+	return movePages(pid, uintptr(len(pages)), unsafe.Pointer(&pages[0]), unsafe.Pointer(unsafe.SliceData(nodes)), unsafe.Pointer(&status[0]), flags)
+}
+
+//sys	rseq(rseq *Rseq, rseqLen uint32, flags int, sig uint32) (err error) = SYS_RSEQ
+//sysnb	getcpu(cpu *uint32, node *uint32) (err error)
+
+// Getcpu returns the CPU and NUMA node on which the calling thread is
+// running. The result may be stale by the time it is used unless the thread
+// is pinned to a single CPU.
+func Getcpu() (cpu, node int, err error) {
+	var c, n uint32
+	if err := getcpu(&c, &n); err != nil {
+		return 0, 0, err
+	}
+	return int(c), int(n), nil
+}
+
+//sys	Membarrier(cmd int, flags uint, cpuID int) (ret int, err error) = SYS_MEMBARRIER
+
+//sys	PkeyAlloc(flags uint, accessRights uint) (pkey int, err error) = SYS_PKEY_ALLOC
+//sys	PkeyFree(pkey int) (err error) = SYS_PKEY_FREE
+//sys	PkeyMprotect(b []byte, prot int, pkey int) (err error) = SYS_PKEY_MPROTECT
+
+// IoprioGet and IoprioSet get and set the I/O priority of the threads
+// selected by which, one of IOPRIO_WHO_PROCESS, IOPRIO_WHO_PGRP or
+// IOPRIO_WHO_USER, and who. With IOPRIO_WHO_PROCESS, who is a thread ID and 0
+// selects the calling thread only. See ioprio_set(2).
+//sys	IoprioGet(which int, who int) (prio Ioprio, err error) = SYS_IOPRIO_GET
+//sys	IoprioSet(which int, who int, prio Ioprio) (err error) = SYS_IOPRIO_SET
+
+//sys	quotactl(cmd int, special *byte, id int, addr unsafe.Pointer) (err error)
+
+// QuotactlFd is like Quotactl but identifies the file system by a file
+// descriptor open on it rather than by its block device.
+//sys	QuotactlFd(fd int, cmd int, id int, addr unsafe.Pointer) (err error) = SYS_QUOTACTL_FD
+
+// Qcmd returns the quotactl command performing subcmd, one of the Q_*
+// constants, on quotas of type qtype, one of USRQUOTA, GRPQUOTA or PRJQUOTA.
+func Qcmd(subcmd, qtype int) int {
+	return subcmd<<SUBCMDSHIFT | qtype&SUBCMDMASK
+}
+
+// Quotactl performs the quota command cmd, built with Qcmd, for id on the
+// file system mounted from the block device special. addr points to the
+// argument of the command, such as a Dqblk for Q_GETQUOTA and Q_SETQUOTA or
+// an FsQuotaStat for Q_XGETQSTAT, and may be nil for commands without one.
+// special may be empty for Q_SYNC to sync all file systems. See
+// quotactl(2).
+func Quotactl(cmd int, special string, id int, addr unsafe.Pointer) error {
+	var p *byte
+	if special != "" {
+		var err error
+		p, err = BytePtrFromString(special)
+		if err != nil {
+			return err
+		}
+	}
+	return quotactl(cmd, p, id, addr)
+}
+
+// Kcmp compares the kernel resources of type typ, one of the KCMP_*
+// constants, used by the processes pid1 and pid2. For KCMP_FILE, idx1 and
+// idx2 are file descriptors in pid1 and pid2; the other types compare
+// process-wide resources and ignore them. For KCMP_EPOLL_TFD use
+// KcmpEpollTfd instead.
+//
+// Kcmp returns 0 if the resources are the same, and 1 or 2 if the first is
+// ordered before or after the second, respectively, in an unspecified but
+// stable order. It returns 3 if the resources differ but cannot be ordered.
+// See kcmp(2).
+//sys	Kcmp(pid1 int, pid2 int, typ int, idx1 uintptr, idx2 uintptr) (ret int, err error) = SYS_KCMP
+//sys	kcmpEpollTfd(pid1 int, pid2 int, typ int, fd1 uintptr, slot *KcmpEpollSlot) (ret int, err error) = SYS_KCMP
+
+// KcmpEpollTfd compares the file descriptor fd1 of process pid1 with the
+// target file registered in the epoll instance described by slot in process
+// pid2. The result is as for Kcmp.
+func KcmpEpollTfd(pid1, pid2, fd1 int, slot *KcmpEpollSlot) (int, error) {
+	return kcmpEpollTfd(pid1, pid2, KCMP_EPOLL_TFD, uintptr(fd1), slot)
+}
@@ -18,6 +18,16 @@ func setTimeval(sec, usec int64) Timeval {
 	return Timeval{Sec: int32(sec), Usec: int32(usec)}
 }
 
+// semtimedop uses the 64-bit time variant of the system call, as the 32-bit
+// one is only available through the ipc multiplexer on this architecture.
+func semtimedop(id int, sops []Sembuf, timeout *Timespec) error {
+	if timeout == nil {
+		return semtimedopTime64(id, sops, nil)
+	}
+	ts := KernelTimespec{Sec: int64(timeout.Sec), Nsec: int64(timeout.Nsec)}
+	return semtimedopTime64(id, sops, &ts)
+}
+
 // 64-bit file system and 32-bit uid calls
 // (386 default is 32-bit file system and 16-bit uid).
 //sys	Fadvise(fd int, offset int64, length int64, advice int) (err error) = SYS_FADVISE64_64
@@ -36,6 +46,7 @@ func setTimeval(sec, usec int64) Timeval {
 //sys	pread(fd int, p []byte, offset int64) (n int, err error) = SYS_PREAD64
 //sys	pwrite(fd int, p []byte, offset int64) (n int, err error) = SYS_PWRITE64
 //sys	Renameat(olddirfd int, oldpath string, newdirfd int, newpath string) (err error)
+//sys	semtimedopTime64(id int, sops []Sembuf, timeout *KernelTimespec) (err error) = SYS_SEMTIMEDOP_TIME64
 //sys	sendfile(outfd int, infd int, offset *int64, count int) (written int, err error) = SYS_SENDFILE64
 //sys	setfsgid(gid int) (prev int, err error) = SYS_SETFSGID32
 //sys	setfsuid(uid int) (prev int, err error) = SYS_SETFSUID32
@@ -29,6 +29,7 @@ func Select(nfd int, r *FdSet, w *FdSet, e *FdSet, timeout *Timeval) (n int, err
 	return pselect6(nfd, r, w, e, ts, nil)
 }
 
+//sys	semtimedop(id int, sops []Sembuf, timeout *Timespec) (err error)
 //sys	sendfile(outfd int, infd int, offset *int64, count int) (written int, err error)
 //sys	setfsgid(gid int) (prev int, err error)
 //sys	setfsuid(uid int) (prev int, err error)
@@ -26,6 +26,7 @@ func Syscall9(trap, a1, a2, a3, a4, a5, a6, a7, a8, a9 uintptr) (r1, r2 uintptr,
 //sys	pwrite(fd int, p []byte, offset int64) (n int, err error) = SYS_PWRITE64
 //sys	Renameat(olddirfd int, oldpath string, newdirfd int, newpath string) (err error)
 //sys	Select(nfd int, r *FdSet, w *FdSet, e *FdSet, timeout *Timeval) (n int, err error) = SYS__NEWSELECT
+//sys	semtimedopTime64(id int, sops []Sembuf, timeout *KernelTimespec) (err error) = SYS_SEMTIMEDOP_TIME64
 //sys	sendfile(outfd int, infd int, offset *int64, count int) (written int, err error) = SYS_SENDFILE64
 //sys	setfsgid(gid int) (prev int, err error)
 //sys	setfsuid(uid int) (prev int, err error)
@@ -102,6 +103,16 @@ func setTimeval(sec, usec int64) Timeval {
 	return Timeval{Sec: int32(sec), Usec: int32(usec)}
 }
 
+// semtimedop uses the 64-bit time variant of the system call, as the 32-bit
+// one is only available through the ipc multiplexer on this architecture.
+func semtimedop(id int, sops []Sembuf, timeout *Timespec) error {
+	if timeout == nil {
+		return semtimedopTime64(id, sops, nil)
+	}
+	ts := KernelTimespec{Sec: int64(timeout.Sec), Nsec: int64(timeout.Nsec)}
+	return semtimedopTime64(id, sops, &ts)
+}
+
 //sys	mmap2(addr uintptr, length uintptr, prot int, flags int, fd int, pageOffset uintptr) (xaddr uintptr, err error)
 
 func mmap(addr uintptr, length uintptr, prot int, flags int, fd int, offset int64) (xaddr uintptr, err error) {
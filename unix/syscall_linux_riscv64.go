@@ -33,6 +33,7 @@ func Select(nfd int, r *FdSet, w *FdSet, e *FdSet, timeout *Timeval) (n int, err
 	return pselect6(nfd, r, w, e, ts, nil)
 }
 
+//sys	semtimedop(id int, sops []Sembuf, timeout *Timespec) (err error)
 //sys	sendfile(outfd int, infd int, offset *int64, count int) (written int, err error)
 //sys	setfsgid(gid int) (prev int, err error)
 //sys	setfsuid(uid int) (prev int, err error)
@@ -190,4 +191,37 @@ func RISCVHWProbe(pairs []RISCVHWProbePairs, set *CPUSet, flags uint) (err error
 	return riscvHWProbe(pairs, setSize, set, flags)
 }
 
+// RISCVHWProbeValues returns the values of the riscv_hwprobe keys common to
+// the CPUs in set, or to all online CPUs if set is nil, indexed by key. Keys
+// unknown to the kernel are left out of the result.
+func RISCVHWProbeValues(set *CPUSet, keys ...int64) (map[int64]uint64, error) {
+	pairs := make([]RISCVHWProbePairs, len(keys))
+	for i, key := range keys {
+		pairs[i].Key = key
+	}
+	if err := RISCVHWProbe(pairs, set, 0); err != nil {
+		return nil, err
+	}
+	values := make(map[int64]uint64, len(pairs))
+	for _, p := range pairs {
+		if p.Key != -1 {
+			values[p.Key] = p.Value
+		}
+	}
+	return values, nil
+}
+
+// RISCVHWProbeWhichCPUs removes from set the CPUs that do not match all of
+// pairs, using the RISCV_HWPROBE_WHICH_CPUS flag. A CPU matches a pair whose
+// key is a bitmask, such as RISCV_HWPROBE_KEY_IMA_EXT_0, if it has all the
+// bits of the value, and other pairs if it has the exact value. An empty set
+// is first filled with all online CPUs. A key unknown to the kernel matches
+// no CPU.
+func RISCVHWProbeWhichCPUs(pairs []RISCVHWProbePairs, set *CPUSet) error {
+	if set == nil {
+		return EINVAL
+	}
+	return RISCVHWProbe(pairs, set, RISCV_HWPROBE_WHICH_CPUS)
+}
+
 const SYS_FSTATAT = SYS_NEWFSTATAT
@@ -0,0 +1,42 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix_test
+
+import (
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestRISCVHWProbe(t *testing.T) {
+	values, err := unix.RISCVHWProbeValues(nil, unix.RISCV_HWPROBE_KEY_BASE_BEHAVIOR, unix.RISCV_HWPROBE_KEY_IMA_EXT_0, 1<<40)
+	if err == unix.ENOSYS {
+		t.Skip("riscv_hwprobe not supported")
+	} else if err != nil {
+		t.Fatalf("RISCVHWProbeValues: %v", err)
+	}
+	if _, ok := values[1<<40]; ok {
+		t.Errorf("RISCVHWProbeValues reported unknown key")
+	}
+	base := values[unix.RISCV_HWPROBE_KEY_BASE_BEHAVIOR]
+	if base&unix.RISCV_HWPROBE_BASE_BEHAVIOR_IMA == 0 {
+		t.Errorf("RISCVHWProbeValues: base behavior %#x lacks IMA", base)
+	}
+
+	var set, online unix.CPUSet
+	if err := unix.SchedGetaffinity(0, &online); err != nil {
+		t.Fatal(err)
+	}
+	pairs := []unix.RISCVHWProbePairs{{Key: unix.RISCV_HWPROBE_KEY_IMA_EXT_0, Value: values[unix.RISCV_HWPROBE_KEY_IMA_EXT_0]}}
+	if err := unix.RISCVHWProbeWhichCPUs(pairs, &set); err == unix.EINVAL {
+		t.Skip("RISCV_HWPROBE_WHICH_CPUS not supported")
+	} else if err != nil {
+		t.Fatalf("RISCVHWProbeWhichCPUs: %v", err)
+	}
+	// Every CPU has the extensions common to all CPUs.
+	if set.Count() < online.Count() {
+		t.Errorf("RISCVHWProbeWhichCPUs: %d CPUs match, want at least %d", set.Count(), online.Count())
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"net"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
 	"runtime"
 	"runtime/debug"
@@ -1377,3 +1378,551 @@ func TestSockaddrALG(t *testing.T) {
 		t.Fatalf("got: %q, want: %q", got, exp)
 	}
 }
+
+func TestFscryptKeyIdentifier(t *testing.T) {
+	key := make([]byte, unix.FSCRYPT_MAX_KEY_SIZE)
+	for i := range key {
+		key[i] = byte(i)
+	}
+	id, err := unix.FscryptKeyIdentifier(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const exp = "8699c2c53707405da5aba5ae4d8583c0"
+	if got := hex.EncodeToString(id[:]); got != exp {
+		t.Fatalf("got: %q, want: %q", got, exp)
+	}
+
+	var spec unix.FscryptKeySpecifier
+	spec.SetIdentifier(id)
+	if spec.Type != unix.FSCRYPT_KEY_SPEC_TYPE_IDENTIFIER || spec.Identifier() != id {
+		t.Fatalf("unexpected key specifier %+v", spec)
+	}
+
+	if _, err := unix.FscryptKeyIdentifier(nil); err != unix.EINVAL {
+		t.Fatalf("FscryptKeyIdentifier(nil): got %v, want EINVAL", err)
+	}
+}
+
+func TestFiemap(t *testing.T) {
+	f, err := os.Create(filepath.Join(t.TempDir(), "fiemap"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteAt(make([]byte, 1<<16), 1<<20); err != nil {
+		t.Fatal(err)
+	}
+
+	fd := int(f.Fd())
+	value := unix.Fiemap{Length: unix.FIEMAP_MAX_OFFSET, Flags: unix.FIEMAP_FLAG_SYNC}
+	err = unix.IoctlFiemap(fd, &value, nil)
+	if err == unix.EOPNOTSUPP || err == unix.ENOTTY {
+		t.Skip("filesystem does not support FIEMAP, skipping test")
+	} else if err != nil {
+		t.Fatalf("IoctlFiemap: %v", err)
+	}
+	if value.Mapped_extents == 0 {
+		t.Fatalf("IoctlFiemap: no extents counted")
+	}
+
+	extents, err := unix.FiemapExtents(fd, 0, unix.FIEMAP_MAX_OFFSET, 0)
+	if err != nil {
+		t.Fatalf("FiemapExtents: %v", err)
+	}
+	if len(extents) == 0 || !extents[len(extents)-1].Last() {
+		t.Fatalf("FiemapExtents: got %+v, want extents ending with FIEMAP_EXTENT_LAST", extents)
+	}
+	for _, e := range extents {
+		if e.Logical+e.Length <= 1<<20 {
+			t.Errorf("unexpected extent %+v before written data", e)
+		}
+	}
+}
+
+func TestMemPolicy(t *testing.T) {
+	var allowed unix.NodeSet
+	_, err := unix.GetMemPolicy(&allowed, nil, unix.MPOL_F_MEMS_ALLOWED)
+	if err == unix.ENOSYS || err == unix.EPERM {
+		t.Skipf("get_mempolicy: %v, skipping test", err)
+	} else if err != nil {
+		t.Fatalf("GetMemPolicy: %v", err)
+	}
+	if allowed.Count() == 0 {
+		t.Fatalf("GetMemPolicy: no allowed nodes")
+	}
+	node := 0
+	for !allowed.IsSet(node) {
+		node++
+	}
+
+	dynAllowed := unix.NewNodeSet(1024)
+	if _, err := unix.GetMemPolicyDynamic(dynAllowed, nil, unix.MPOL_F_MEMS_ALLOWED); err != nil {
+		t.Fatalf("GetMemPolicyDynamic: %v", err)
+	}
+	if dynAllowed.Count() != allowed.Count() || !dynAllowed.IsSet(node) {
+		t.Errorf("GetMemPolicyDynamic: got %v, want %v", dynAllowed, allowed)
+	}
+
+	b, err := unix.Mmap(-1, 0, os.Getpagesize(), unix.PROT_READ|unix.PROT_WRITE, unix.MAP_ANON|unix.MAP_PRIVATE)
+	if err != nil {
+		t.Fatalf("Mmap: %v", err)
+	}
+	defer unix.Munmap(b)
+
+	var nodes unix.NodeSet
+	nodes.Set(node)
+	if err := unix.Mbind(b, unix.MPOL_BIND, &nodes, 0); err != nil {
+		t.Fatalf("Mbind: %v", err)
+	}
+	b[0] = 1 // fault in the page
+
+	mode, err := unix.GetMemPolicy(&nodes, unsafe.Pointer(&b[0]), unix.MPOL_F_ADDR)
+	if err != nil {
+		t.Fatalf("GetMemPolicy: %v", err)
+	}
+	if mode != unix.MPOL_BIND || nodes.Count() != 1 || !nodes.IsSet(node) {
+		t.Errorf("GetMemPolicy: got mode %d nodes %v, want mode %d node %d", mode, nodes, unix.MPOL_BIND, node)
+	}
+	got, err := unix.GetMemPolicy(nil, unsafe.Pointer(&b[0]), unix.MPOL_F_NODE|unix.MPOL_F_ADDR)
+	if err != nil {
+		t.Fatalf("GetMemPolicy: %v", err)
+	}
+	if got != node {
+		t.Errorf("GetMemPolicy: page is on node %d, want %d", got, node)
+	}
+
+	pages := []unsafe.Pointer{unsafe.Pointer(&b[0])}
+	status := make([]int32, 1)
+	if err := unix.MovePages(0, pages, nil, status, 0); err != nil {
+		t.Fatalf("MovePages: %v", err)
+	}
+	if int(status[0]) != node {
+		t.Errorf("MovePages: page is on node %d, want %d", status[0], node)
+	}
+
+	if _, err := unix.MigratePages(0, &nodes, &nodes); err != nil {
+		t.Fatalf("MigratePages: %v", err)
+	}
+}
+
+func TestMincore(t *testing.T) {
+	pagesize := os.Getpagesize()
+	b, err := unix.Mmap(-1, 0, 4*pagesize, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_ANON|unix.MAP_PRIVATE)
+	if err != nil {
+		t.Fatalf("Mmap: %v", err)
+	}
+	defer unix.Munmap(b)
+
+	b[pagesize] = 1 // fault in the second page
+	resident, err := unix.MincoreResident(b)
+	if err != nil {
+		t.Fatalf("MincoreResident: %v", err)
+	}
+	if len(resident) != 4 {
+		t.Fatalf("MincoreResident: got %d pages, want 4", len(resident))
+	}
+	if !resident[1] {
+		t.Errorf("MincoreResident: written page is not resident")
+	}
+
+	if err := unix.Mincore(b, make([]byte, 3)); err != unix.EINVAL {
+		t.Errorf("Mincore with short vector: got %v, want EINVAL", err)
+	}
+}
+
+func TestProcessMadvise(t *testing.T) {
+	pidfd, err := unix.PidfdOpen(os.Getpid(), 0)
+	if err == unix.ENOSYS || err == unix.EPERM {
+		t.Skipf("pidfd_open: %v, skipping test", err)
+	} else if err != nil {
+		t.Fatalf("PidfdOpen: %v", err)
+	}
+	defer unix.Close(pidfd)
+
+	pagesize := os.Getpagesize()
+	b, err := unix.Mmap(-1, 0, 2*pagesize, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_ANON|unix.MAP_PRIVATE)
+	if err != nil {
+		t.Fatalf("Mmap: %v", err)
+	}
+	defer unix.Munmap(b)
+	b[0] = 1
+
+	iovs := []unix.RemoteIovec{{Base: uintptr(unsafe.Pointer(&b[0])), Len: len(b)}}
+	n, err := unix.ProcessMadvise(pidfd, iovs, unix.MADV_COLD, 0)
+	switch err {
+	case nil:
+	case unix.ENOSYS, unix.EPERM, unix.EINVAL:
+		t.Skipf("process_madvise: %v, skipping test", err)
+	default:
+		t.Fatalf("ProcessMadvise: %v", err)
+	}
+	if n != len(b) {
+		t.Errorf("ProcessMadvise: advised %d bytes, want %d", n, len(b))
+	}
+
+	// The process is not exiting, so process_mrelease must fail.
+	if err := unix.ProcessMrelease(pidfd, 0); err == nil {
+		t.Errorf("ProcessMrelease on a live process: expected error")
+	}
+}
+
+func TestMqueue(t *testing.T) {
+	name := fmt.Sprintf("/golang.org-x-sys-unix-test-%d", os.Getpid())
+	attr := unix.MqAttr{Maxmsg: 4, Msgsize: 64}
+	mqd, err := unix.MqOpen(name, unix.O_RDWR|unix.O_CREAT|unix.O_EXCL|unix.O_CLOEXEC, 0600, &attr)
+	if err == unix.ENOSYS || err == unix.EACCES || err == unix.EPERM {
+		t.Skipf("mq_open: %v, skipping test", err)
+	} else if err != nil {
+		t.Fatalf("MqOpen: %v", err)
+	}
+	defer unix.Close(mqd)
+	defer unix.MqUnlink(name)
+
+	if _, err := unix.MqOpen("no-slash", unix.O_RDONLY, 0, nil); err != unix.EINVAL {
+		t.Errorf("MqOpen without leading slash: got %v, want EINVAL", err)
+	}
+
+	var got unix.MqAttr
+	if err := unix.MqGetsetattr(mqd, nil, &got); err != nil {
+		t.Fatalf("MqGetsetattr: %v", err)
+	}
+	if got.Maxmsg != attr.Maxmsg || got.Msgsize != attr.Msgsize || got.Curmsgs != 0 {
+		t.Errorf("MqGetsetattr: got %+v, want %+v", got, attr)
+	}
+
+	// Register for a signal on the empty queue before sending.
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, unix.SIGUSR1)
+	defer signal.Stop(sigs)
+	sev := unix.Sigevent{Notify: unix.SIGEV_SIGNAL, Signo: int32(unix.SIGUSR1)}
+	if err := unix.MqNotify(mqd, &sev); err != nil {
+		t.Fatalf("MqNotify: %v", err)
+	}
+	if err := unix.MqNotify(mqd, &sev); err != unix.EBUSY {
+		t.Errorf("second MqNotify: got %v, want EBUSY", err)
+	}
+
+	if err := unix.MqTimedsend(mqd, []byte("low"), 1, nil); err != nil {
+		t.Fatalf("MqTimedsend: %v", err)
+	}
+	if err := unix.MqTimedsend(mqd, []byte("high"), 5, nil); err != nil {
+		t.Fatalf("MqTimedsend: %v", err)
+	}
+	select {
+	case <-sigs:
+	case <-time.After(10 * time.Second):
+		t.Errorf("MqNotify: no signal received")
+	}
+
+	buf := make([]byte, attr.Msgsize)
+	for _, want := range []struct {
+		msg  string
+		prio uint32
+	}{{"high", 5}, {"low", 1}} {
+		var prio uint32
+		n, err := unix.MqTimedreceive(mqd, buf, &prio, nil)
+		if err != nil {
+			t.Fatalf("MqTimedreceive: %v", err)
+		}
+		if string(buf[:n]) != want.msg || prio != want.prio {
+			t.Errorf("MqTimedreceive: got %q with priority %d, want %q with priority %d", buf[:n], prio, want.msg, want.prio)
+		}
+	}
+
+	ts, err := unix.TimeToTimespec(time.Now().Add(10 * time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := unix.MqTimedreceive(mqd, buf, nil, &ts); err != unix.ETIMEDOUT {
+		t.Errorf("MqTimedreceive on empty queue: got %v, want ETIMEDOUT", err)
+	}
+
+	if err := unix.MqGetsetattr(mqd, &unix.MqAttr{Flags: unix.O_NONBLOCK}, nil); err != nil {
+		t.Fatalf("MqGetsetattr: %v", err)
+	}
+	if _, err := unix.MqTimedreceive(mqd, buf, nil, nil); err != unix.EAGAIN {
+		t.Errorf("MqTimedreceive on non-blocking empty queue: got %v, want EAGAIN", err)
+	}
+}
+
+func TestPosixTimer(t *testing.T) {
+	id, err := unix.TimerCreate(unix.CLOCK_MONOTONIC, &unix.Sigevent{Notify: unix.SIGEV_NONE})
+	if err != nil {
+		t.Fatalf("TimerCreate: %v", err)
+	}
+	spec := unix.ItimerSpec{Value: unix.NsecToTimespec(int64(time.Hour))}
+	if err := unix.TimerSettime(id, 0, &spec, nil); err != nil {
+		t.Fatalf("TimerSettime: %v", err)
+	}
+	var cur unix.ItimerSpec
+	if err := unix.TimerGettime(id, &cur); err != nil {
+		t.Fatalf("TimerGettime: %v", err)
+	}
+	if left := time.Duration(cur.Value.Nano()); left <= 0 || left > time.Hour {
+		t.Errorf("TimerGettime: %v until expiration, want (0, 1h]", left)
+	}
+	if n, err := unix.TimerGetoverrun(id); err != nil || n != 0 {
+		t.Errorf("TimerGetoverrun: got %d, %v, want 0, nil", n, err)
+	}
+	if err := unix.TimerDelete(id); err != nil {
+		t.Fatalf("TimerDelete: %v", err)
+	}
+	if err := unix.TimerDelete(id); err != unix.EINVAL {
+		t.Errorf("second TimerDelete: got %v, want EINVAL", err)
+	}
+}
+
+func TestCPUTimer(t *testing.T) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	tid := unix.Gettid()
+	for _, clock := range []int32{unix.ProcessCPUClockID(0), unix.ProcessCPUClockID(os.Getpid()), unix.ThreadCPUClockID(0), unix.ThreadCPUClockID(tid)} {
+		var ts unix.Timespec
+		if err := unix.ClockGettime(clock, &ts); err != nil {
+			t.Fatalf("ClockGettime(%d): %v", clock, err)
+		}
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, unix.SIGUSR2)
+	defer signal.Stop(sigs)
+
+	sev := unix.Sigevent{Notify: unix.SIGEV_THREAD_ID, Signo: int32(unix.SIGUSR2), Tid: int32(tid)}
+	id, err := unix.TimerCreate(unix.ThreadCPUClockID(0), &sev)
+	if err != nil {
+		t.Fatalf("TimerCreate: %v", err)
+	}
+	defer unix.TimerDelete(id)
+	spec := unix.ItimerSpec{Value: unix.NsecToTimespec(int64(10 * time.Millisecond))}
+	if err := unix.TimerSettime(id, 0, &spec, nil); err != nil {
+		t.Fatalf("TimerSettime: %v", err)
+	}
+
+	// Burn CPU on this thread until the timer fires.
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		select {
+		case <-sigs:
+			return
+		default:
+		}
+	}
+	t.Errorf("thread CPU timer did not fire")
+}
+
+func TestSysvSemTimedOp(t *testing.T) {
+	id, err := unix.SysvSemGet(unix.IPC_PRIVATE, 1, unix.IPC_CREAT|unix.IPC_EXCL|0o600)
+	if err == unix.ENOSYS {
+		t.Skip("semget not supported")
+	} else if err != nil {
+		t.Fatalf("SysvSemGet: %v", err)
+	}
+	defer unix.SysvSemCtl(id, 0, unix.IPC_RMID, nil)
+
+	ops := []unix.Sembuf{{Num: 0, Op: -1}}
+	ts := unix.NsecToTimespec(int64(10 * time.Millisecond))
+	if err := unix.SysvSemTimedOp(id, ops, &ts); err != unix.EAGAIN {
+		t.Errorf("SysvSemTimedOp: got %v, want EAGAIN", err)
+	}
+	ops[0].Op = 1
+	if err := unix.SysvSemTimedOp(id, ops, &ts); err != nil {
+		t.Errorf("SysvSemTimedOp: %v", err)
+	}
+}
+
+// TestRseq runs in a child process with the rseq registration of glibc
+// 2.35 and later disabled, as RseqRegister fails with EBUSY otherwise.
+func TestRseq(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
+		exe, err := os.Executable()
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmd := exec.Command(exe, "-test.run=^TestRseq$", "-test.v")
+		cmd.Env = append(os.Environ(), "GO_WANT_HELPER_PROCESS=1", "GLIBC_TUNABLES=glibc.pthread.rseq=0")
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("child process: %v\n%s", err, out)
+		}
+		if bytes.Contains(out, []byte("--- SKIP")) {
+			t.Skipf("child process skipped:\n%s", out)
+		}
+		return
+	}
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	cpu, node, err := unix.Getcpu()
+	if err != nil {
+		t.Fatalf("Getcpu: %v", err)
+	}
+	if cpu < 0 || node < 0 {
+		t.Fatalf("Getcpu: got cpu %d, node %d", cpu, node)
+	}
+
+	const sig = 0x53053053
+	r, err := unix.RseqRegister(sig)
+	switch err {
+	case nil:
+	case unix.ENOSYS, unix.EPERM:
+		t.Skipf("rseq not supported: %v", err)
+	case unix.EBUSY:
+		t.Skip("rseq area registered by the C library")
+	default:
+		t.Fatalf("RseqRegister: %v", err)
+	}
+	if got := r.CPU(); got < 0 || got >= runtime.NumCPU()*64 {
+		t.Errorf("CPU: got %d", got)
+	}
+	if got := r.Node(); got < -1 {
+		t.Errorf("Node: got %d", got)
+	}
+	if got := r.MmCID(); got < -1 {
+		t.Errorf("MmCID: got %d", got)
+	}
+	if err := unix.RseqUnregister(r, sig); err != nil {
+		t.Fatalf("RseqUnregister: %v", err)
+	}
+	if got := r.CPU(); got != unix.RSEQ_CPU_ID_UNINITIALIZED {
+		t.Errorf("CPU after RseqUnregister: got %d, want %d", got, unix.RSEQ_CPU_ID_UNINITIALIZED)
+	}
+	if err := unix.RseqUnregister(r, sig); err != unix.EINVAL {
+		t.Errorf("second RseqUnregister: got %v, want EINVAL", err)
+	}
+}
+
+func TestMembarrier(t *testing.T) {
+	cmds, err := unix.Membarrier(unix.MEMBARRIER_CMD_QUERY, 0, 0)
+	if err == unix.ENOSYS {
+		t.Skip("membarrier not supported")
+	} else if err != nil {
+		t.Fatalf("Membarrier(MEMBARRIER_CMD_QUERY): %v", err)
+	}
+	if cmds&unix.MEMBARRIER_CMD_PRIVATE_EXPEDITED == 0 {
+		t.Skip("MEMBARRIER_CMD_PRIVATE_EXPEDITED not supported")
+	}
+	if _, err := unix.Membarrier(unix.MEMBARRIER_CMD_REGISTER_PRIVATE_EXPEDITED, 0, 0); err != nil {
+		t.Fatalf("Membarrier(MEMBARRIER_CMD_REGISTER_PRIVATE_EXPEDITED): %v", err)
+	}
+	if _, err := unix.Membarrier(unix.MEMBARRIER_CMD_PRIVATE_EXPEDITED, 0, 0); err != nil {
+		t.Errorf("Membarrier(MEMBARRIER_CMD_PRIVATE_EXPEDITED): %v", err)
+	}
+}
+
+func TestIoprio(t *testing.T) {
+	p := unix.IoprioValue(unix.IOPRIO_CLASS_BE, 7, unix.IOPRIO_HINT_DEV_DURATION_LIMIT_3)
+	if p.Class() != unix.IOPRIO_CLASS_BE || p.Level() != 7 || p.Hint() != unix.IOPRIO_HINT_DEV_DURATION_LIMIT_3 {
+		t.Errorf("IoprioValue: got class %d, level %d, hint %d", p.Class(), p.Level(), p.Hint())
+	}
+	if p.Data() != 3<<unix.IOPRIO_HINT_SHIFT|7 {
+		t.Errorf("Data: got %#x", p.Data())
+	}
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	old, err := unix.IoprioGet(unix.IOPRIO_WHO_PROCESS, 0)
+	if err == unix.ENOSYS {
+		t.Skip("ioprio_get not supported")
+	} else if err != nil {
+		t.Fatalf("IoprioGet: %v", err)
+	}
+	defer unix.IoprioSet(unix.IOPRIO_WHO_PROCESS, 0, old)
+
+	want := unix.IoprioValue(unix.IOPRIO_CLASS_BE, 7, unix.IOPRIO_HINT_NONE)
+	if err := unix.IoprioSet(unix.IOPRIO_WHO_PROCESS, 0, want); err != nil {
+		t.Fatalf("IoprioSet: %v", err)
+	}
+	got, err := unix.IoprioGet(unix.IOPRIO_WHO_PROCESS, unix.Gettid())
+	if err != nil {
+		t.Fatalf("IoprioGet: %v", err)
+	}
+	if got != want {
+		t.Errorf("IoprioGet: got %#x, want %#x", got, want)
+	}
+}
+
+func TestQuotactl(t *testing.T) {
+	if got := uint32(unix.Qcmd(unix.Q_GETQUOTA, unix.PRJQUOTA)); got != 0x80000702 {
+		t.Errorf("Qcmd(Q_GETQUOTA, PRJQUOTA): got %#x, want 0x80000702", got)
+	}
+
+	var dq unix.Dqblk
+	err := unix.Quotactl(unix.Qcmd(unix.Q_GETQUOTA, unix.USRQUOTA), "/dev/nonexistent", os.Getuid(), unsafe.Pointer(&dq))
+	if err == unix.ENOSYS || err == unix.EPERM {
+		t.Skipf("quotactl unavailable: %v", err)
+	} else if err != unix.ENOENT {
+		t.Errorf("Quotactl on missing device: got %v, want ENOENT", err)
+	}
+
+	f, err := os.Open(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var stat unix.FsQuotaStat
+	err = unix.QuotactlFd(int(f.Fd()), unix.Qcmd(unix.Q_XGETQSTAT, unix.USRQUOTA), 0, unsafe.Pointer(&stat))
+	switch err {
+	case nil:
+		if stat.Version != unix.FS_QSTAT_VERSION {
+			t.Errorf("FsQuotaStat.Version: got %d, want %d", stat.Version, unix.FS_QSTAT_VERSION)
+		}
+	case unix.ENOSYS, unix.EPERM:
+		t.Skipf("quotactl_fd unavailable: %v", err)
+	case unix.ENOTSUP, unix.ESRCH, unix.ENOENT:
+		// Quotas are typically not enabled on the temporary directory's
+		// file system.
+		t.Skipf("quotas not enabled: %v", err)
+	default:
+		t.Fatalf("QuotactlFd(Q_XGETQSTAT): %v", err)
+	}
+}
+
+func TestKcmp(t *testing.T) {
+	pid := os.Getpid()
+	if _, err := unix.Kcmp(pid, pid, unix.KCMP_VM, 0, 0); err == unix.ENOSYS || err == unix.EPERM {
+		t.Skipf("kcmp not available: %v", err)
+	} else if err != nil {
+		t.Fatalf("Kcmp(KCMP_VM): %v", err)
+	}
+
+	var p [2]int
+	if err := unix.Pipe(p[:]); err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(p[0])
+	defer unix.Close(p[1])
+	dup, err := unix.Dup(p[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(dup)
+
+	if r, err := unix.Kcmp(pid, pid, unix.KCMP_FILE, uintptr(p[0]), uintptr(dup)); err != nil || r != 0 {
+		t.Errorf("Kcmp(KCMP_FILE) on duplicated descriptor: got %d, %v; want 0", r, err)
+	}
+	if r, err := unix.Kcmp(pid, pid, unix.KCMP_FILE, uintptr(p[0]), uintptr(p[1])); err != nil || r == 0 {
+		t.Errorf("Kcmp(KCMP_FILE) on different files: got %d, %v; want nonzero", r, err)
+	}
+
+	efd, err := unix.EpollCreate1(unix.EPOLL_CLOEXEC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(efd)
+	if err := unix.EpollCtl(efd, unix.EPOLL_CTL_ADD, dup, &unix.EpollEvent{Events: unix.EPOLLIN}); err != nil {
+		t.Fatal(err)
+	}
+	slot := unix.KcmpEpollSlot{Efd: uint32(efd), Tfd: uint32(dup)}
+	r, err := unix.KcmpEpollTfd(pid, pid, p[0], &slot)
+	if err == unix.EOPNOTSUPP || err == unix.EINVAL {
+		t.Skipf("KCMP_EPOLL_TFD not supported: %v", err)
+	}
+	if err != nil || r != 0 {
+		t.Errorf("KcmpEpollTfd: got %d, %v; want 0", r, err)
+	}
+}
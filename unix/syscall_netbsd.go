@@ -109,6 +109,15 @@ func direntNamlen(buf []byte) (uint64, bool) {
 	return readInt(buf, unsafe.Offsetof(Dirent{}.Namlen), unsafe.Sizeof(Dirent{}.Namlen))
 }
 
+func direntType(buf []byte) (uint64, bool) {
+	return readInt(buf, unsafe.Offsetof(Dirent{}.Type), unsafe.Sizeof(Dirent{}.Type))
+}
+
+func direntOff(buf []byte) (uint64, bool) {
+	// d_off is not available.
+	return 0, true
+}
+
 func SysctlUvmexp(name string) (*Uvmexp, error) {
 	mib, err := sysctlmib(name)
 	if err != nil {
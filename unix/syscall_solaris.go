@@ -55,6 +55,15 @@ func direntNamlen(buf []byte) (uint64, bool) {
 	return reclen - uint64(unsafe.Offsetof(Dirent{}.Name)), true
 }
 
+func direntType(buf []byte) (uint64, bool) {
+	// d_type is not available.
+	return 0, true
+}
+
+func direntOff(buf []byte) (uint64, bool) {
+	return readInt(buf, unsafe.Offsetof(Dirent{}.Off), unsafe.Sizeof(Dirent{}.Off))
+}
+
 //sysnb	pipe(p *[2]_C_int) (n int, err error)
 
 func Pipe(p []int) (err error) {
@@ -2901,6 +2901,16 @@ func direntNamlen(buf []byte) (uint64, bool) {
 	return reclen - uint64(unsafe.Offsetof(Dirent{}.Name)), true
 }
 
+func direntType(buf []byte) (uint64, bool) {
+	return readInt(buf, unsafe.Offsetof(Dirent{}.Type), unsafe.Sizeof(Dirent{}.Type))
+}
+
+func direntOff(buf []byte) (uint64, bool) {
+	// Getdirentries is emulated with readdir, which keeps an entry count
+	// as its offset.
+	return 0, true
+}
+
 func direntLeToDirentUnix(dirent *direntLE, dir uintptr, path string) (Dirent, error) {
 	var d Dirent
 
@@ -0,0 +1,46 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build (darwin && !ios) || linux
+
+package unix
+
+import "unsafe"
+
+// SysvMsgGet returns the Sysv message queue identifier associated with key.
+// If the IPC_CREAT flag is specified a new queue is created.
+func SysvMsgGet(key, flag int) (id int, err error) {
+	return msgget(key, flag)
+}
+
+// SysvMsgCtl performs control operations on the message queue specified by
+// id.
+func SysvMsgCtl(id, cmd int, desc *SysvMsqDesc) (result int, err error) {
+	return msgctl(id, sysvCtlCmd(cmd), desc)
+}
+
+// SysvMsgSnd sends a message of type typ, which must be positive, with the
+// contents text to the message queue id.
+func SysvMsgSnd(id, typ int, text []byte, flag int) error {
+	buf := make([]byte, SizeofLong+len(text))
+	*(*_C_long)(unsafe.Pointer(&buf[0])) = _C_long(typ)
+	copy(buf[SizeofLong:], text)
+	return msgsnd(id, unsafe.Pointer(&buf[0]), uintptr(len(text)), flag)
+}
+
+// SysvMsgRcv receives a message from the message queue id into text and
+// returns its type and length. If typ is 0, the first message on the queue
+// is received; if it is positive, the first message of type typ; and if it
+// is negative, the first message with the lowest type not greater than -typ.
+// A message longer than text fails with E2BIG unless flag includes
+// MSG_NOERROR, in which case it is truncated.
+func SysvMsgRcv(id int, text []byte, typ, flag int) (mtype, n int, err error) {
+	buf := make([]byte, SizeofLong+len(text))
+	n, err = msgrcv(id, unsafe.Pointer(&buf[0]), uintptr(len(text)), typ, flag)
+	if err != nil {
+		return 0, 0, err
+	}
+	copy(text, buf[SizeofLong:SizeofLong+n])
+	return int(*(*_C_long)(unsafe.Pointer(&buf[0]))), n, nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build (darwin && !ios) || linux
+
+package unix_test
+
+import (
+	"runtime"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestSysvMessageQueue(t *testing.T) {
+	id, err := unix.SysvMsgGet(unix.IPC_PRIVATE, unix.IPC_CREAT|unix.IPC_EXCL|0o600)
+	if runtime.GOOS == "android" {
+		if err != unix.ENOSYS {
+			t.Fatalf("expected android to fail, but it didn't")
+		}
+		return
+	}
+	if err == unix.ENOSYS {
+		t.Skip("msgget not supported")
+	}
+	if err != nil {
+		t.Fatalf("SysvMsgGet: %v", err)
+	}
+	defer func() {
+		if _, err := unix.SysvMsgCtl(id, unix.IPC_RMID, nil); err != nil {
+			t.Errorf("Remove failed: %v", err)
+		}
+	}()
+
+	if err := unix.SysvMsgSnd(id, 2, []byte("second"), 0); err != nil {
+		t.Fatalf("SysvMsgSnd: %v", err)
+	}
+	if err := unix.SysvMsgSnd(id, 1, []byte("first"), 0); err != nil {
+		t.Fatalf("SysvMsgSnd: %v", err)
+	}
+
+	var desc unix.SysvMsqDesc
+	if _, err := unix.SysvMsgCtl(id, unix.IPC_STAT, &desc); err != nil {
+		t.Fatalf("IPC_STAT: %v", err)
+	}
+	if desc.Qnum != 2 || desc.Cbytes != 11 || int(desc.Lspid) != unix.Getpid() {
+		t.Errorf("IPC_STAT: got %d messages of %d bytes from pid %d, want 2 of 11 bytes from pid %d", desc.Qnum, desc.Cbytes, desc.Lspid, unix.Getpid())
+	}
+
+	buf := make([]byte, 16)
+	if _, _, err := unix.SysvMsgRcv(id, buf[:3], 1, unix.IPC_NOWAIT); err != unix.E2BIG {
+		t.Errorf("SysvMsgRcv into short buffer: got %v, want E2BIG", err)
+	}
+	// Lowest type not greater than 2.
+	typ, n, err := unix.SysvMsgRcv(id, buf, -2, unix.IPC_NOWAIT)
+	if err != nil {
+		t.Fatalf("SysvMsgRcv: %v", err)
+	}
+	if typ != 1 || string(buf[:n]) != "first" {
+		t.Errorf("SysvMsgRcv: got type %d %q, want type 1 %q", typ, buf[:n], "first")
+	}
+	typ, n, err = unix.SysvMsgRcv(id, buf[:3], 0, unix.IPC_NOWAIT|unix.MSG_NOERROR)
+	if err != nil {
+		t.Fatalf("SysvMsgRcv: %v", err)
+	}
+	if typ != 2 || string(buf[:n]) != "sec" {
+		t.Errorf("SysvMsgRcv with MSG_NOERROR: got type %d %q, want type 2 %q", typ, buf[:n], "sec")
+	}
+	if _, _, err := unix.SysvMsgRcv(id, buf, 0, unix.IPC_NOWAIT); err != unix.ENOMSG {
+		t.Errorf("SysvMsgRcv on empty queue: got %v, want ENOMSG", err)
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build darwin && !ios
+
+package unix
+
+// SysvSemOp atomically performs the operations ops on the semaphore set id.
+func SysvSemOp(id int, ops []Sembuf) error {
+	return semop(id, ops)
+}
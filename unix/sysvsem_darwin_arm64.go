@@ -0,0 +1,34 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix
+
+import "unsafe"
+
+// semctl(int, int, int, ...) is variadic in libc, and on darwin/arm64
+// variadic arguments are passed on the stack, which syscall_syscall6 does
+// not do for the fourth argument. It is called through a trampoline that
+// does, in sysvsem_darwin_arm64.s.
+
+func semctl(id int, num int, cmd int, arg uintptr) (result int, err error) {
+	r0, _, e1 := syscall_syscall6(semctlTrampolineAddr, uintptr(id), uintptr(num), uintptr(cmd), uintptr(arg), 0, 0)
+	result = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+func semctlPtr(id int, num int, cmd int, arg unsafe.Pointer) (result int, err error) {
+	r0, _, e1 := syscall_syscall6(semctlTrampolineAddr, uintptr(id), uintptr(num), uintptr(cmd), uintptr(arg), 0, 0)
+	result = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+var semctlTrampolineAddr uintptr
+
+//go:cgo_import_dynamic libc_semctl semctl "/usr/lib/libSystem.B.dylib"
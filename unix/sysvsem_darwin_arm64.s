@@ -0,0 +1,18 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+#include "textflag.h"
+
+// semctl_trampoline calls semctl(int, int, int, ...) with its fourth
+// argument, the variadic one, on the stack.
+TEXT semctl_trampoline<>(SB),NOSPLIT|NOFRAME,$0-0
+	SUB	$16, RSP
+	MOVD	R3, 0(RSP)
+	MOVD	R30, 8(RSP)
+	BL	libc_semctl(SB)
+	MOVD	8(RSP), R30
+	ADD	$16, RSP
+	RET
+GLOBL	·semctlTrampolineAddr(SB), RODATA, $8
+DATA	·semctlTrampolineAddr(SB)/8, $semctl_trampoline<>(SB)
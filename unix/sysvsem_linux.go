@@ -0,0 +1,19 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package unix
+
+// SysvSemOp atomically performs the operations ops on the semaphore set id.
+func SysvSemOp(id int, ops []Sembuf) error {
+	return semtimedop(id, ops, nil)
+}
+
+// SysvSemTimedOp is like SysvSemOp, but fails with EAGAIN if the operations
+// cannot be performed within the relative timeout. If timeout is nil, it
+// waits indefinitely.
+func SysvSemTimedOp(id int, ops []Sembuf, timeout *Timespec) error {
+	return semtimedop(id, ops, timeout)
+}
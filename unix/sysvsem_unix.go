@@ -0,0 +1,70 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build (darwin && !ios) || linux
+
+package unix
+
+import "unsafe"
+
+// SysvSemGet returns the Sysv semaphore set identifier associated with key.
+// If the IPC_CREAT flag is specified a new set of nsems semaphores is
+// created.
+func SysvSemGet(key, nsems, flag int) (id int, err error) {
+	return semget(key, nsems, flag)
+}
+
+// SysvSemCtl performs control operations on the semaphore set specified by
+// id, or on its semaphore num. The desc argument is used by IPC_STAT and
+// IPC_SET and may be nil for other commands. For GETVAL, GETPID, GETNCNT and
+// GETZCNT the requested value is returned as result.
+func SysvSemCtl(id, num, cmd int, desc *SysvSemDesc) (result int, err error) {
+	return semctlPtr(id, num, sysvCtlCmd(cmd), unsafe.Pointer(desc))
+}
+
+// SysvSemSetVal sets the value of the semaphore num of the set id.
+func SysvSemSetVal(id, num, val int) error {
+	arg := uintptr(val)
+	if isBigEndian && unsafe.Sizeof(arg) == 8 {
+		// The value is the int member of union semun, which the kernel
+		// reads from the high half of the argument on 64-bit big-endian
+		// architectures.
+		arg <<= 32
+	}
+	_, err := semctl(id, num, SETVAL, arg)
+	return err
+}
+
+// SysvSemGetAll stores the values of the semaphores of the set id in vals,
+// which must have room for all of them.
+func SysvSemGetAll(id int, vals []uint16) error {
+	if err := semCheckAll(id, vals); err != nil {
+		return err
+	}
+	_, err := semctlPtr(id, 0, GETALL, unsafe.Pointer(&vals[0]))
+	return err
+}
+
+// SysvSemSetAll sets the values of the semaphores of the set id to vals,
+// which must have a value for each of them.
+func SysvSemSetAll(id int, vals []uint16) error {
+	if err := semCheckAll(id, vals); err != nil {
+		return err
+	}
+	_, err := semctlPtr(id, 0, SETALL, unsafe.Pointer(&vals[0]))
+	return err
+}
+
+// semCheckAll reports EINVAL if vals is too short for the semaphore set id,
+// since GETALL and SETALL access as many values as there are semaphores.
+func semCheckAll(id int, vals []uint16) error {
+	var desc SysvSemDesc
+	if _, err := SysvSemCtl(id, 0, IPC_STAT, &desc); err != nil {
+		return err
+	}
+	if len(vals) == 0 || len(vals) < int(desc.Nsems) {
+		return EINVAL
+	}
+	return nil
+}
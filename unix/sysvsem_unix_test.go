@@ -0,0 +1,79 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build (darwin && !ios) || linux
+
+package unix_test
+
+import (
+	"runtime"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestSysvSemaphore(t *testing.T) {
+	id, err := unix.SysvSemGet(unix.IPC_PRIVATE, 2, unix.IPC_CREAT|unix.IPC_EXCL|0o600)
+	if runtime.GOOS == "android" {
+		if err != unix.ENOSYS {
+			t.Fatalf("expected android to fail, but it didn't")
+		}
+		return
+	}
+	if err == unix.ENOSYS {
+		t.Skip("semget not supported")
+	}
+	if err != nil {
+		t.Fatalf("SysvSemGet: %v", err)
+	}
+	defer func() {
+		if _, err := unix.SysvSemCtl(id, 0, unix.IPC_RMID, nil); err != nil {
+			t.Errorf("Remove failed: %v", err)
+		}
+	}()
+
+	var desc unix.SysvSemDesc
+	if _, err := unix.SysvSemCtl(id, 0, unix.IPC_STAT, &desc); err != nil {
+		t.Fatalf("IPC_STAT: %v", err)
+	}
+	if desc.Nsems != 2 || desc.Perm.Mode&0o777 != 0o600 {
+		t.Errorf("IPC_STAT: got %d semaphores with mode %#o, want 2 with mode 0600", desc.Nsems, desc.Perm.Mode&0o777)
+	}
+
+	if err := unix.SysvSemSetAll(id, []uint16{3, 5}); err != nil {
+		t.Fatalf("SysvSemSetAll: %v", err)
+	}
+	if err := unix.SysvSemSetAll(id, []uint16{3}); err != unix.EINVAL {
+		t.Errorf("SysvSemSetAll with too few values: got %v, want EINVAL", err)
+	}
+
+	ops := []unix.Sembuf{{Num: 0, Op: -1}, {Num: 1, Op: 2}}
+	if err := unix.SysvSemOp(id, ops); err != nil {
+		t.Fatalf("SysvSemOp: %v", err)
+	}
+	vals := make([]uint16, 2)
+	if err := unix.SysvSemGetAll(id, vals); err != nil {
+		t.Fatalf("SysvSemGetAll: %v", err)
+	}
+	if vals[0] != 2 || vals[1] != 7 {
+		t.Errorf("SysvSemGetAll: got %v, want [2 7]", vals)
+	}
+
+	for _, want := range []int{4, 0} {
+		if err := unix.SysvSemSetVal(id, 0, want); err != nil {
+			t.Fatalf("SysvSemSetVal(%d): %v", want, err)
+		}
+		if val, err := unix.SysvSemCtl(id, 0, unix.GETVAL, nil); err != nil || val != want {
+			t.Errorf("GETVAL: got %d, %v, want %d, nil", val, err, want)
+		}
+	}
+	if pid, err := unix.SysvSemCtl(id, 0, unix.GETPID, nil); err != nil || pid != unix.Getpid() {
+		t.Errorf("GETPID: got %d, %v, want %d, nil", pid, err, unix.Getpid())
+	}
+
+	ops = []unix.Sembuf{{Num: 0, Op: -1, Flg: unix.IPC_NOWAIT}}
+	if err := unix.SysvSemOp(id, ops); err != unix.EAGAIN {
+		t.Errorf("SysvSemOp with IPC_NOWAIT: got %v, want EAGAIN", err)
+	}
+}
@@ -11,10 +11,16 @@ import "runtime"
 // SysvShmCtl performs control operations on the shared memory segment
 // specified by id.
 func SysvShmCtl(id, cmd int, desc *SysvShmDesc) (result int, err error) {
+	return shmctl(id, sysvCtlCmd(cmd), desc)
+}
+
+// sysvCtlCmd adds IPC_64 to cmd on the architectures where the shmctl,
+// semctl and msgctl system calls otherwise use the old structure layouts.
+func sysvCtlCmd(cmd int) int {
 	if runtime.GOARCH == "arm" ||
 		runtime.GOARCH == "mips64" || runtime.GOARCH == "mips64le" {
 		cmd |= ipc_64
 	}
 
-	return shmctl(id, cmd, desc)
+	return cmd
 }
@@ -31,10 +31,12 @@ package unix
 #include <sys/kern_control.h>
 #include <sys/mman.h>
 #include <sys/mount.h>
+#include <sys/msg.h>
 #include <sys/param.h>
 #include <sys/ptrace.h>
 #include <sys/resource.h>
 #include <sys/select.h>
+#include <sys/sem.h>
 #include <sys/shm.h>
 #include <sys/signal.h>
 #include <sys/socket.h>
@@ -394,3 +396,28 @@ const (
 	SHM_RDONLY = C.SHM_RDONLY
 	SHM_RND    = C.SHM_RND
 )
+
+// sem
+
+type Sembuf C.struct_sembuf
+type SysvSemDesc C.struct_semid_ds
+
+const (
+	GETNCNT = C.GETNCNT
+	GETPID  = C.GETPID
+	GETVAL  = C.GETVAL
+	GETALL  = C.GETALL
+	GETZCNT = C.GETZCNT
+	SETVAL  = C.SETVAL
+	SETALL  = C.SETALL
+
+	SEM_UNDO = C.SEM_UNDO
+)
+
+// msg
+
+type SysvMsqDesc C.struct_msqid_ds
+
+const (
+	MSG_NOERROR = C.MSG_NOERROR
+)
@@ -0,0 +1,411 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix
+
+import (
+	"encoding/binary"
+	"unsafe"
+)
+
+// VDSOSymbol is a function exported by the vDSO.
+type VDSOSymbol struct {
+	Name    string
+	Version string  // Version, such as "LINUX_2.6", or "" if unversioned
+	Addr    uintptr // Address of the function
+	Size    uintptr // Size of the function
+}
+
+// VDSO describes a vDSO, the shared library the kernel maps into every
+// process to speed up system calls such as clock_gettime.
+type VDSO struct {
+	Base     uintptr      // Address of the ELF header
+	Versions []string     // Version definitions, such as "LINUX_2.6"
+	Symbols  []VDSOSymbol // Exported functions
+}
+
+// CurrentVDSO parses the vDSO of the current process, found through the
+// AT_SYSINFO_EHDR entry of the auxiliary vector. It returns ENOENT if the
+// process has no vDSO.
+func CurrentVDSO() (*VDSO, error) {
+	b, base, err := currentVDSOImage()
+	if err != nil {
+		return nil, err
+	}
+	return ParseVDSO(b, base)
+}
+
+// currentVDSOImage returns the image of the vDSO of the current process
+// and its address.
+func currentVDSOImage() ([]byte, uintptr, error) {
+	auxv, err := CurrentAuxVector()
+	if err != nil {
+		return nil, 0, err
+	}
+	base := auxv.SysinfoEHDR()
+	if base == 0 {
+		return nil, 0, ENOENT
+	}
+	mem := func(n int) []byte {
+		return unsafe.Slice((*byte)(unsafe.Pointer(base)), n)
+	}
+
+	// Map in the ELF header, then the program headers, then the
+	// loadable segment, which holds everything else the parser needs.
+	size := vdsoHeaderSize(mem(6))
+	if size == 0 {
+		return nil, 0, ENOEXEC
+	}
+	f, err := newVDSOFile(mem(size))
+	if err != nil {
+		return nil, 0, err
+	}
+	size = f.phoff + f.phnum*f.phentsize
+	if f, err = newVDSOFile(mem(size)); err != nil {
+		return nil, 0, err
+	}
+	end, err := f.loadEnd()
+	if err != nil {
+		return nil, 0, err
+	}
+	return mem(max(end, size)), base, nil
+}
+
+// ParseVDSO parses the vDSO image b, such as a copy of the [vdso] mapping
+// of a process read from /proc/<pid>/mem, which is mapped at address base
+// in that process.
+func ParseVDSO(b []byte, base uintptr) (*VDSO, error) {
+	f, err := newVDSOFile(b)
+	if err != nil {
+		return nil, err
+	}
+	return f.parse(base)
+}
+
+// Constants from the ELF specification.
+const (
+	_EI_CLASS    = 4
+	_EI_DATA     = 5
+	_ELFCLASS32  = 1
+	_ELFCLASS64  = 2
+	_ELFDATA2LSB = 1
+	_ELFDATA2MSB = 2
+
+	_PT_LOAD    = 1
+	_PT_DYNAMIC = 2
+
+	_DT_NULL     = 0
+	_DT_HASH     = 4
+	_DT_STRTAB   = 5
+	_DT_SYMTAB   = 6
+	_DT_VERSYM   = 0x6ffffff0
+	_DT_GNU_HASH = 0x6ffffef5
+	_DT_VERDEF   = 0x6ffffffc
+
+	_STT_NOTYPE = 0
+	_STT_FUNC   = 2
+	_STB_GLOBAL = 1
+	_STB_WEAK   = 2
+	_SHN_UNDEF  = 0
+
+	_VER_FLG_BASE = 0x1
+)
+
+// vdsoFile is an ELF image being parsed. All offsets and addresses are
+// checked against the bounds of b, and all values are kept as int.
+type vdsoFile struct {
+	b         []byte
+	bo        binary.ByteOrder
+	is64      bool
+	phoff     int
+	phentsize int
+	phnum     int
+
+	loadBias int // file offset minus virtual address of the loadable segment
+}
+
+// vdsoHeaderSize returns the size of the ELF header that starts with
+// ident, or 0 if ident is not an ELF identification.
+func vdsoHeaderSize(ident []byte) int {
+	if string(ident[:4]) != "\x7fELF" {
+		return 0
+	}
+	switch ident[_EI_CLASS] {
+	case _ELFCLASS32:
+		return 52
+	case _ELFCLASS64:
+		return 64
+	}
+	return 0
+}
+
+func newVDSOFile(b []byte) (*vdsoFile, error) {
+	if len(b) < 6 || vdsoHeaderSize(b) == 0 || len(b) < vdsoHeaderSize(b) {
+		return nil, ENOEXEC
+	}
+	f := &vdsoFile{b: b, is64: b[_EI_CLASS] == _ELFCLASS64}
+	switch b[_EI_DATA] {
+	case _ELFDATA2LSB:
+		f.bo = binary.LittleEndian
+	case _ELFDATA2MSB:
+		f.bo = binary.BigEndian
+	default:
+		return nil, ENOEXEC
+	}
+	if f.is64 {
+		f.phoff = f.word(0x20)
+		f.phentsize = f.half(0x36)
+		f.phnum = f.half(0x38)
+	} else {
+		f.phoff = f.word(0x1c)
+		f.phentsize = f.half(0x2a)
+		f.phnum = f.half(0x2c)
+	}
+	if f.phoff <= 0 || f.phentsize < f.sizes(32, 56) {
+		return nil, ENOEXEC
+	}
+	return f, nil
+}
+
+// sizes returns n32 or n64 depending on the class of f.
+func (f *vdsoFile) sizes(n32, n64 int) int {
+	if f.is64 {
+		return n64
+	}
+	return n32
+}
+
+// in reports whether the n bytes at off are within the image.
+func (f *vdsoFile) in(off, n int) bool {
+	return off >= 0 && n >= 0 && off <= len(f.b) && n <= len(f.b)-off
+}
+
+func (f *vdsoFile) half(off int) int {
+	if !f.in(off, 2) {
+		return -1
+	}
+	return int(f.bo.Uint16(f.b[off:]))
+}
+
+// u32 reads a 32-bit offset, index or count, or returns -1 if it is out
+// of bounds or does not fit in an int.
+func (f *vdsoFile) u32(off int) int {
+	v, ok := f.raw32(off)
+	if !ok || uint(v) > ^uint(0)>>1 {
+		return -1
+	}
+	return int(v)
+}
+
+// raw32 reads a 32-bit value that is not used as an offset, such as a
+// hash.
+func (f *vdsoFile) raw32(off int) (uint32, bool) {
+	if !f.in(off, 4) {
+		return 0, false
+	}
+	return f.bo.Uint32(f.b[off:]), true
+}
+
+// word reads a word of the class of f, which is 4 or 8 bytes.
+func (f *vdsoFile) word(off int) int {
+	if !f.is64 {
+		return f.u32(off)
+	}
+	if !f.in(off, 8) {
+		return -1
+	}
+	v := f.bo.Uint64(f.b[off:])
+	if v > uint64(^uint(0)>>1) {
+		return -1
+	}
+	return int(v)
+}
+
+func (f *vdsoFile) str(off int) string {
+	if !f.in(off, 0) {
+		return ""
+	}
+	for i := off; i < len(f.b); i++ {
+		if f.b[i] == 0 {
+			return string(f.b[off:i])
+		}
+	}
+	return ""
+}
+
+// prog returns the type, file offset, virtual address and file size of
+// program header i.
+func (f *vdsoFile) prog(i int) (typ, off, vaddr, filesz int) {
+	ph := f.phoff + i*f.phentsize
+	if f.is64 {
+		return f.u32(ph), f.word(ph + 8), f.word(ph + 16), f.word(ph + 32)
+	}
+	return f.u32(ph), f.word(ph + 4), f.word(ph + 8), f.word(ph + 16)
+}
+
+// loadEnd returns the end of the loadable segment in the image and sets
+// the load bias.
+func (f *vdsoFile) loadEnd() (int, error) {
+	for i := range f.phnum {
+		typ, off, vaddr, filesz := f.prog(i)
+		if typ == _PT_LOAD {
+			if off < 0 || vaddr < 0 || filesz < 0 {
+				return 0, ENOEXEC
+			}
+			f.loadBias = off - vaddr
+			return off + filesz, nil
+		}
+	}
+	return 0, ENOEXEC
+}
+
+func (f *vdsoFile) parse(base uintptr) (*VDSO, error) {
+	if _, err := f.loadEnd(); err != nil {
+		return nil, err
+	}
+
+	// Find the dynamic section and the tables it points to. The tables
+	// are given by virtual address, which the load bias converts to file
+	// offsets.
+	dyn, dynsz := -1, 0
+	for i := range f.phnum {
+		typ, off, _, filesz := f.prog(i)
+		if typ == _PT_DYNAMIC {
+			dyn, dynsz = off, filesz
+		}
+	}
+	if !f.in(dyn, dynsz) {
+		return nil, ENOEXEC
+	}
+	var hash, gnuHash, strtab, symtab, versym, verdef = -1, -1, -1, -1, -1, -1
+	entsize := f.sizes(8, 16)
+	for d := dyn; d+entsize <= dyn+dynsz; d += entsize {
+		tag, val := f.word(d), f.word(d+entsize/2)
+		if f.is64 && tag == -1 {
+			// Tags with the high bit set are processor specific.
+			continue
+		}
+		ptr := val + f.loadBias
+		switch tag {
+		case _DT_HASH:
+			hash = ptr
+		case _DT_GNU_HASH:
+			gnuHash = ptr
+		case _DT_STRTAB:
+			strtab = ptr
+		case _DT_SYMTAB:
+			symtab = ptr
+		case _DT_VERSYM:
+			versym = ptr
+		case _DT_VERDEF:
+			verdef = ptr
+		}
+		if tag == _DT_NULL {
+			break
+		}
+	}
+	if strtab < 0 || symtab < 0 || hash < 0 && gnuHash < 0 {
+		return nil, ENOEXEC
+	}
+
+	var nsym int
+	if hash >= 0 {
+		nsym = f.u32(hash + 4) // nchain
+	} else {
+		nsym = f.gnuHashSymbols(gnuHash)
+	}
+	if nsym < 0 || nsym > len(f.b) {
+		return nil, ENOEXEC
+	}
+
+	v := &VDSO{Base: base}
+
+	// Version definitions, indexed by their version index.
+	names := map[int]string{}
+	if verdef >= 0 {
+		for def, n := verdef, 0; n < 1000; n++ {
+			flags, ndx, aux, next := f.half(def+2), f.half(def+4), f.u32(def+12), f.u32(def+16)
+			if flags < 0 || ndx < 0 || aux < 0 || next < 0 {
+				return nil, ENOEXEC
+			}
+			name := f.str(strtab + f.u32(def+aux))
+			if flags&_VER_FLG_BASE == 0 {
+				names[ndx&0x7fff] = name
+				v.Versions = append(v.Versions, name)
+			}
+			if next == 0 {
+				break
+			}
+			def += next
+		}
+	}
+
+	symsize := f.sizes(16, 24)
+	for i := 1; i < nsym; i++ {
+		sym := symtab + i*symsize
+		if !f.in(sym, symsize) {
+			return nil, ENOEXEC
+		}
+		var name, info, shndx, value, size int
+		if f.is64 {
+			name, info, shndx, value, size = f.u32(sym), int(f.b[sym+4]), f.half(sym+6), f.word(sym+8), f.word(sym+16)
+		} else {
+			name, info, shndx, value, size = f.u32(sym), int(f.b[sym+12]), f.half(sym+14), f.word(sym+4), f.word(sym+8)
+		}
+		typ, bind := info&0xf, info>>4
+		if typ != _STT_FUNC && typ != _STT_NOTYPE || bind != _STB_GLOBAL && bind != _STB_WEAK || shndx == _SHN_UNDEF {
+			continue
+		}
+		s := VDSOSymbol{
+			Name: f.str(strtab + name),
+			Addr: base + uintptr(f.loadBias) + uintptr(value),
+			Size: uintptr(size),
+		}
+		if versym >= 0 {
+			s.Version = names[f.half(versym+2*i)&0x7fff]
+		}
+		v.Symbols = append(v.Symbols, s)
+	}
+	return v, nil
+}
+
+// gnuHashSymbols returns the number of symbols in the symbol table
+// described by the GNU hash table at off, which does not record it.
+func (f *vdsoFile) gnuHashSymbols(off int) int {
+	nbuckets, symoffset, bloomSize := f.u32(off), f.u32(off+4), f.u32(off+8)
+	if nbuckets <= 0 || symoffset < 0 || bloomSize < 0 {
+		return -1
+	}
+	buckets := off + 16 + bloomSize*f.sizes(4, 8)
+	chains := buckets + nbuckets*4
+	last := 0
+	for i := range nbuckets {
+		last = max(last, f.u32(buckets+4*i))
+	}
+	if last < symoffset {
+		return symoffset
+	}
+	// Follow the chain of the last bucket to its end.
+	for {
+		h, ok := f.raw32(chains + 4*(last-symoffset))
+		if !ok {
+			return -1
+		}
+		if h&1 != 0 {
+			return last + 1
+		}
+		last++
+	}
+}
+
+// Lookup returns the symbol with the given name and, unless version is
+// empty, version.
+func (v *VDSO) Lookup(name, version string) (VDSOSymbol, bool) {
+	for _, s := range v.Symbols {
+		if s.Name == name && (version == "" || s.Version == version) {
+			return s, true
+		}
+	}
+	return VDSOSymbol{}, false
+}
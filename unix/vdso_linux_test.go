@@ -0,0 +1,47 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix_test
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestVDSO(t *testing.T) {
+	vdso, err := unix.CurrentVDSO()
+	if err == unix.ENOENT {
+		t.Skip("no vDSO")
+	} else if err != nil {
+		t.Fatalf("CurrentVDSO: %v", err)
+	}
+	if len(vdso.Versions) == 0 {
+		t.Errorf("no version definitions")
+	}
+	found := false
+	for _, s := range vdso.Symbols {
+		t.Logf("%s@%s at %#x, %d bytes", s.Name, s.Version, s.Addr, s.Size)
+		if s.Addr < vdso.Base {
+			t.Errorf("%s: address %#x below base %#x", s.Name, s.Addr, vdso.Base)
+		}
+		if strings.HasSuffix(s.Name, "clock_gettime") {
+			found = true
+			if got, ok := vdso.Lookup(s.Name, s.Version); !ok || got != s {
+				t.Errorf("Lookup(%q, %q) = %v, %v", s.Name, s.Version, got, ok)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("clock_gettime not found in the vDSO")
+	}
+	if _, ok := vdso.Lookup("clock_gettime", "LINUX_0.0"); ok {
+		t.Errorf("Lookup found a symbol with a bogus version")
+	}
+
+	if _, err := unix.ParseVDSO([]byte("\x7fELF\x02\x01garbage"), 0); err == nil {
+		t.Errorf("ParseVDSO: expected error on truncated image")
+	}
+}
@@ -278,3 +278,62 @@ func LlistxattrNS(link string, nsid int, dest []byte) (sz int, err error) {
 
 	return s, nil
 }
+
+// ListxattrNames returns the names of the extended attributes of the file
+// at path in the user and system namespaces. Unlike Listxattr, each name
+// carries its "user." or "system." prefix so that it can be passed back to
+// Getxattr.
+func ListxattrNames(file string) ([]string, error) {
+	return listxattrNames(func(nsid int, dest []byte) (int, error) {
+		return ExtattrListFile(file, nsid, uintptr(initxattrdest(dest, 0)), len(dest))
+	})
+}
+
+// LlistxattrNames is like ListxattrNames but does not follow a symbolic
+// link at link.
+func LlistxattrNames(link string) ([]string, error) {
+	return listxattrNames(func(nsid int, dest []byte) (int, error) {
+		return ExtattrListLink(link, nsid, uintptr(initxattrdest(dest, 0)), len(dest))
+	})
+}
+
+// FlistxattrNames is like ListxattrNames but operates on the open file fd.
+func FlistxattrNames(fd int) ([]string, error) {
+	return listxattrNames(func(nsid int, dest []byte) (int, error) {
+		return ExtattrListFd(fd, nsid, uintptr(initxattrdest(dest, 0)), len(dest))
+	})
+}
+
+// listxattrNames decodes the length-prefixed names returned by list for
+// each namespace and prepends the namespace prefix.
+func listxattrNames(list func(nsid int, dest []byte) (int, error)) ([]string, error) {
+	var names []string
+	for _, ns := range [...]struct {
+		id     int
+		prefix string
+	}{
+		{EXTATTR_NAMESPACE_USER, "user."},
+		{EXTATTR_NAMESPACE_SYSTEM, "system."},
+	} {
+		buf, err := xattrRead(func(dest []byte) (int, error) {
+			return list(ns.id, dest)
+		})
+		if err != nil {
+			// As with Listxattr, ignore permission errors for the
+			// system namespace.
+			if err == EPERM && ns.id != EXTATTR_NAMESPACE_USER {
+				continue
+			}
+			return nil, err
+		}
+		for len(buf) > 0 {
+			n := int(buf[0])
+			if 1+n > len(buf) {
+				break
+			}
+			names = append(names, ns.prefix+string(buf[1:1+n]))
+			buf = buf[1+n:]
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build darwin || linux
+
+package unix
+
+import "bytes"
+
+// ListxattrNames returns the names of the extended attributes of the file
+// at path.
+func ListxattrNames(path string) ([]string, error) {
+	return listxattrNames(func(dest []byte) (int, error) {
+		return Listxattr(path, dest)
+	})
+}
+
+// LlistxattrNames is like ListxattrNames but does not follow a symbolic
+// link at path.
+func LlistxattrNames(link string) ([]string, error) {
+	return listxattrNames(func(dest []byte) (int, error) {
+		return Llistxattr(link, dest)
+	})
+}
+
+// FlistxattrNames is like ListxattrNames but operates on the open file fd.
+func FlistxattrNames(fd int) ([]string, error) {
+	return listxattrNames(func(dest []byte) (int, error) {
+		return Flistxattr(fd, dest)
+	})
+}
+
+// listxattrNames splits the NUL-terminated names returned by list.
+func listxattrNames(list func(dest []byte) (int, error)) ([]string, error) {
+	buf, err := xattrRead(list)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for len(buf) > 0 {
+		name, rest, _ := bytes.Cut(buf, []byte{0})
+		if len(name) > 0 {
+			names = append(names, string(name))
+		}
+		buf = rest
+	}
+	return names, nil
+}
@@ -197,3 +197,65 @@ func TestFdXattr(t *testing.T) {
 		t.Fatalf("Fremovexattr: %v", err)
 	}
 }
+
+func TestXattrNames(t *testing.T) {
+	chtmpdir(t)
+
+	f := "xattr3"
+	touch(t, f)
+
+	xattrName := "user.test"
+	xattrDataSet := strings.Repeat("gopher", 100)
+
+	err := unix.Setxattr(f, xattrName, []byte(xattrDataSet), 0)
+	if err == unix.ENOTSUP || err == unix.EOPNOTSUPP {
+		t.Skip("filesystem does not support extended attributes, skipping test")
+	} else if err != nil {
+		t.Fatalf("Setxattr: %v", err)
+	}
+
+	names, err := unix.ListxattrNames(f)
+	if err != nil {
+		t.Fatalf("ListxattrNames: %v", err)
+	}
+	if !slices.Contains(names, xattrName) {
+		t.Errorf("ListxattrNames did not return previously set attribute %q in attributes %v", xattrName, names)
+	}
+
+	got, err := unix.GetxattrBytes(f, xattrName)
+	if err != nil {
+		t.Fatalf("GetxattrBytes: %v", err)
+	}
+	if string(got) != xattrDataSet {
+		t.Errorf("GetxattrBytes: expected attribute value %s, got %s", xattrDataSet, got)
+	}
+
+	fd, err := unix.Open(f, unix.O_RDONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(fd)
+
+	names, err = unix.FlistxattrNames(fd)
+	if err != nil {
+		t.Fatalf("FlistxattrNames: %v", err)
+	}
+	if !slices.Contains(names, xattrName) {
+		t.Errorf("FlistxattrNames did not return previously set attribute %q in attributes %v", xattrName, names)
+	}
+
+	got, err = unix.FgetxattrBytes(fd, xattrName)
+	if err != nil {
+		t.Fatalf("FgetxattrBytes: %v", err)
+	}
+	if string(got) != xattrDataSet {
+		t.Errorf("FgetxattrBytes: expected attribute value %s, got %s", xattrDataSet, got)
+	}
+
+	if err := unix.Removexattr(f, xattrName); err != nil {
+		t.Fatalf("Removexattr: %v", err)
+	}
+	if _, err := unix.GetxattrBytes(f, xattrName); err == nil {
+		t.Errorf("GetxattrBytes: expected error after Removexattr")
+	}
+}
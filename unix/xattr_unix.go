@@ -0,0 +1,56 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build darwin || freebsd || linux || netbsd
+
+package unix
+
+// GetxattrBytes returns the value of the extended attribute attr of the
+// file at path, allocating a buffer of the required size. Attribute names
+// use the Linux "namespace.name" form on all platforms that have
+// namespaces, as with Getxattr.
+func GetxattrBytes(path string, attr string) ([]byte, error) {
+	return xattrRead(func(dest []byte) (int, error) {
+		return Getxattr(path, attr, dest)
+	})
+}
+
+// LgetxattrBytes is like GetxattrBytes but does not follow a symbolic link
+// at path.
+func LgetxattrBytes(link string, attr string) ([]byte, error) {
+	return xattrRead(func(dest []byte) (int, error) {
+		return Lgetxattr(link, attr, dest)
+	})
+}
+
+// FgetxattrBytes is like GetxattrBytes but operates on the open file fd.
+func FgetxattrBytes(fd int, attr string) ([]byte, error) {
+	return xattrRead(func(dest []byte) (int, error) {
+		return Fgetxattr(fd, attr, dest)
+	})
+}
+
+// xattrRead calls get with a buffer large enough to hold the whole result,
+// retrying if the attribute or list grows between the size probe and the
+// read. Linux and Darwin report this with ERANGE while FreeBSD and NetBSD
+// silently truncate, so one spare byte is allocated to detect the latter.
+func xattrRead(get func(dest []byte) (int, error)) ([]byte, error) {
+	for {
+		sz, err := get(nil)
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, sz+1)
+		n, err := get(buf)
+		if err == ERANGE {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if n < len(buf) {
+			return buf[:n], nil
+		}
+	}
+}
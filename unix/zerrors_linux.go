@@ -8,6 +8,17 @@ import "syscall"
 
 const (
 	AAFS_MAGIC                                  = 0x5a3c69f0
+	ACL_EXECUTE                                 = 0x1
+	ACL_GROUP                                   = 0x8
+	ACL_GROUP_OBJ                               = 0x4
+	ACL_MASK                                    = 0x10
+	ACL_OTHER                                   = 0x20
+	ACL_READ                                    = 0x4
+	ACL_TYPE_ACCESS                             = 0x8000
+	ACL_TYPE_DEFAULT                            = 0x4000
+	ACL_USER                                    = 0x2
+	ACL_USER_OBJ                                = 0x1
+	ACL_WRITE                                   = 0x2
 	ADFS_SUPER_MAGIC                            = 0xadf5
 	AFFS_SUPER_MAGIC                            = 0xadff
 	AFS_FS_MAGIC                                = 0x6b414653
@@ -1370,6 +1381,22 @@ const (
 	FIB_RULE_PERMANENT                          = 0x1
 	FIB_RULE_UNRESOLVED                         = 0x4
 	FIDEDUPERANGE                               = 0xc0189436
+	FIEMAP_EXTENT_DATA_ENCRYPTED                = 0x80
+	FIEMAP_EXTENT_DATA_INLINE                   = 0x200
+	FIEMAP_EXTENT_DATA_TAIL                     = 0x400
+	FIEMAP_EXTENT_DELALLOC                      = 0x4
+	FIEMAP_EXTENT_ENCODED                       = 0x8
+	FIEMAP_EXTENT_LAST                          = 0x1
+	FIEMAP_EXTENT_MERGED                        = 0x1000
+	FIEMAP_EXTENT_NOT_ALIGNED                   = 0x100
+	FIEMAP_EXTENT_SHARED                        = 0x2000
+	FIEMAP_EXTENT_UNKNOWN                       = 0x2
+	FIEMAP_EXTENT_UNWRITTEN                     = 0x800
+	FIEMAP_FLAGS_COMPAT                         = 0x3
+	FIEMAP_FLAG_CACHE                           = 0x4
+	FIEMAP_FLAG_SYNC                            = 0x1
+	FIEMAP_FLAG_XATTR                           = 0x2
+	FIEMAP_MAX_OFFSET                           = 0xffffffffffffffff
 	FSCRYPT_ADD_KEY_FLAG_HW_WRAPPED             = 0x1
 	FSCRYPT_KEY_DESCRIPTOR_SIZE                 = 0x8
 	FSCRYPT_KEY_DESC_PREFIX                     = "fscrypt:"
@@ -1411,6 +1438,7 @@ const (
 	FS_ENCRYPTION_MODE_AES_256_XTS              = 0x1
 	FS_ENCRYPTION_MODE_INVALID                  = 0x0
 	FS_IOC_ADD_ENCRYPTION_KEY                   = 0xc0506617
+	FS_IOC_FIEMAP                               = 0xc020660b
 	FS_IOC_GET_ENCRYPTION_KEY_STATUS            = 0xc080661a
 	FS_IOC_GET_ENCRYPTION_POLICY_EX             = 0xc0096616
 	FS_IOC_MEASURE_VERITY                       = 0xc0046686
@@ -2798,6 +2826,9 @@ const (
 	PF_XDP                                      = 0x2c
 	PID_FS_MAGIC                                = 0x50494446
 	PIPEFS_MAGIC                                = 0x50495045
+	PKEY_DISABLE_ACCESS                         = 0x1
+	PKEY_DISABLE_WRITE                          = 0x2
+	POSIX_ACL_XATTR_VERSION                     = 0x2
 	PPPIOCGNPMODE                               = 0xc008744c
 	PPPIOCNEWUNIT                               = 0xc004743e
 	PRIO_PGRP                                   = 0x1
@@ -4105,6 +4136,8 @@ const (
 	WSTOPPED                                    = 0x2
 	WUNTRACED                                   = 0x2
 	XATTR_CREATE                                = 0x1
+	XATTR_NAME_POSIX_ACL_ACCESS                 = "system.posix_acl_access"
+	XATTR_NAME_POSIX_ACL_DEFAULT                = "system.posix_acl_default"
 	XATTR_REPLACE                               = 0x2
 	XDP_COPY                                    = 0x2
 	XDP_FLAGS_DRV_MODE                          = 0x4
@@ -211,6 +211,7 @@ const (
 	PERF_EVENT_IOC_SET_BPF           = 0x40042408
 	PERF_EVENT_IOC_SET_FILTER        = 0x40042406
 	PERF_EVENT_IOC_SET_OUTPUT        = 0x2405
+	PKEY_ACCESS_MASK                 = 0x3
 	PPPIOCATTACH                     = 0x4004743d
 	PPPIOCATTCHAN                    = 0x40047438
 	PPPIOCBRIDGECHAN                 = 0x40047435
@@ -208,6 +208,7 @@ const (
 	PERF_EVENT_IOC_SET_BPF           = 0x80042408
 	PERF_EVENT_IOC_SET_FILTER        = 0x80042406
 	PERF_EVENT_IOC_SET_OUTPUT        = 0x20002405
+	PKEY_ACCESS_MASK                 = 0x3
 	PPPIOCATTACH                     = 0x8004743d
 	PPPIOCATTCHAN                    = 0x80047438
 	PPPIOCBRIDGECHAN                 = 0x80047435
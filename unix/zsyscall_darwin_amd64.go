@@ -934,6 +934,100 @@ var libc_shmget_trampoline_addr uintptr
 
 // THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
 
+func semget(key int, nsems int, flag int) (id int, err error) {
+	r0, _, e1 := syscall_syscall(libc_semget_trampoline_addr, uintptr(key), uintptr(nsems), uintptr(flag))
+	id = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+var libc_semget_trampoline_addr uintptr
+
+//go:cgo_import_dynamic libc_semget semget "/usr/lib/libSystem.B.dylib"
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func semop(id int, sops []Sembuf) (err error) {
+	var _p0 unsafe.Pointer
+	if len(sops) > 0 {
+		_p0 = unsafe.Pointer(&sops[0])
+	} else {
+		_p0 = unsafe.Pointer(&_zero)
+	}
+	_, _, e1 := syscall_syscall(libc_semop_trampoline_addr, uintptr(id), uintptr(_p0), uintptr(len(sops)))
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+var libc_semop_trampoline_addr uintptr
+
+//go:cgo_import_dynamic libc_semop semop "/usr/lib/libSystem.B.dylib"
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func msgget(key int, flag int) (id int, err error) {
+	r0, _, e1 := syscall_syscall(libc_msgget_trampoline_addr, uintptr(key), uintptr(flag), 0)
+	id = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+var libc_msgget_trampoline_addr uintptr
+
+//go:cgo_import_dynamic libc_msgget msgget "/usr/lib/libSystem.B.dylib"
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func msgctl(id int, cmd int, buf *SysvMsqDesc) (result int, err error) {
+	r0, _, e1 := syscall_syscall(libc_msgctl_trampoline_addr, uintptr(id), uintptr(cmd), uintptr(unsafe.Pointer(buf)))
+	result = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+var libc_msgctl_trampoline_addr uintptr
+
+//go:cgo_import_dynamic libc_msgctl msgctl "/usr/lib/libSystem.B.dylib"
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func msgsnd(id int, msgp unsafe.Pointer, size uintptr, flag int) (err error) {
+	_, _, e1 := syscall_syscall6(libc_msgsnd_trampoline_addr, uintptr(id), uintptr(msgp), uintptr(size), uintptr(flag), 0, 0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+var libc_msgsnd_trampoline_addr uintptr
+
+//go:cgo_import_dynamic libc_msgsnd msgsnd "/usr/lib/libSystem.B.dylib"
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func msgrcv(id int, msgp unsafe.Pointer, size uintptr, typ int, flag int) (n int, err error) {
+	r0, _, e1 := syscall_syscall6(libc_msgrcv_trampoline_addr, uintptr(id), uintptr(msgp), uintptr(size), uintptr(typ), uintptr(flag), 0)
+	n = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+var libc_msgrcv_trampoline_addr uintptr
+
+//go:cgo_import_dynamic libc_msgrcv msgrcv "/usr/lib/libSystem.B.dylib"
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
 func Access(path string, mode uint32) (err error) {
 	var _p0 *byte
 	_p0, err = BytePtrFromString(path)
@@ -2726,3 +2820,29 @@ func Statfs(path string, stat *Statfs_t) (err error) {
 var libc_statfs64_trampoline_addr uintptr
 
 //go:cgo_import_dynamic libc_statfs64 statfs64 "/usr/lib/libSystem.B.dylib"
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func semctl(id int, num int, cmd int, arg uintptr) (result int, err error) {
+	r0, _, e1 := syscall_syscall6(libc_semctl_trampoline_addr, uintptr(id), uintptr(num), uintptr(cmd), uintptr(arg), 0, 0)
+	result = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+var libc_semctl_trampoline_addr uintptr
+
+//go:cgo_import_dynamic libc_semctl semctl "/usr/lib/libSystem.B.dylib"
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func semctlPtr(id int, num int, cmd int, arg unsafe.Pointer) (result int, err error) {
+	r0, _, e1 := syscall_syscall6(libc_semctl_trampoline_addr, uintptr(id), uintptr(num), uintptr(cmd), uintptr(arg), 0, 0)
+	result = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
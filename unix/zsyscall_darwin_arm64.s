@@ -278,6 +278,36 @@ TEXT libc_shmget_trampoline<>(SB),NOSPLIT,$0-0
 GLOBL	·libc_shmget_trampoline_addr(SB), RODATA, $8
 DATA	·libc_shmget_trampoline_addr(SB)/8, $libc_shmget_trampoline<>(SB)
 
+TEXT libc_semget_trampoline<>(SB),NOSPLIT,$0-0
+	JMP	libc_semget(SB)
+GLOBL	·libc_semget_trampoline_addr(SB), RODATA, $8
+DATA	·libc_semget_trampoline_addr(SB)/8, $libc_semget_trampoline<>(SB)
+
+TEXT libc_semop_trampoline<>(SB),NOSPLIT,$0-0
+	JMP	libc_semop(SB)
+GLOBL	·libc_semop_trampoline_addr(SB), RODATA, $8
+DATA	·libc_semop_trampoline_addr(SB)/8, $libc_semop_trampoline<>(SB)
+
+TEXT libc_msgget_trampoline<>(SB),NOSPLIT,$0-0
+	JMP	libc_msgget(SB)
+GLOBL	·libc_msgget_trampoline_addr(SB), RODATA, $8
+DATA	·libc_msgget_trampoline_addr(SB)/8, $libc_msgget_trampoline<>(SB)
+
+TEXT libc_msgctl_trampoline<>(SB),NOSPLIT,$0-0
+	JMP	libc_msgctl(SB)
+GLOBL	·libc_msgctl_trampoline_addr(SB), RODATA, $8
+DATA	·libc_msgctl_trampoline_addr(SB)/8, $libc_msgctl_trampoline<>(SB)
+
+TEXT libc_msgsnd_trampoline<>(SB),NOSPLIT,$0-0
+	JMP	libc_msgsnd(SB)
+GLOBL	·libc_msgsnd_trampoline_addr(SB), RODATA, $8
+DATA	·libc_msgsnd_trampoline_addr(SB)/8, $libc_msgsnd_trampoline<>(SB)
+
+TEXT libc_msgrcv_trampoline<>(SB),NOSPLIT,$0-0
+	JMP	libc_msgrcv(SB)
+GLOBL	·libc_msgrcv_trampoline_addr(SB), RODATA, $8
+DATA	·libc_msgrcv_trampoline_addr(SB)/8, $libc_msgrcv_trampoline<>(SB)
+
 TEXT libc_access_trampoline<>(SB),NOSPLIT,$0-0
 	JMP	libc_access(SB)
 GLOBL	·libc_access_trampoline_addr(SB), RODATA, $8
@@ -1991,6 +1991,22 @@ func Munlockall() (err error) {
 
 // THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
 
+func mincore(b []byte, vec *byte) (err error) {
+	var _p0 unsafe.Pointer
+	if len(b) > 0 {
+		_p0 = unsafe.Pointer(&b[0])
+	} else {
+		_p0 = unsafe.Pointer(&_zero)
+	}
+	_, _, e1 := Syscall(SYS_MINCORE, uintptr(_p0), uintptr(len(b)), uintptr(unsafe.Pointer(vec)))
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
 func faccessat(dirfd int, path string, mode uint32) (err error) {
 	var _p0 *byte
 	_p0, err = BytePtrFromString(path)
@@ -2125,6 +2141,33 @@ func PidfdSendSignal(pidfd int, sig Signal, info *Siginfo, flags int) (err error
 
 // THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
 
+func ProcessMadvise(pidfd int, iovs []RemoteIovec, advice int, flags uint) (n int, err error) {
+	var _p0 unsafe.Pointer
+	if len(iovs) > 0 {
+		_p0 = unsafe.Pointer(&iovs[0])
+	} else {
+		_p0 = unsafe.Pointer(&_zero)
+	}
+	r0, _, e1 := Syscall6(SYS_PROCESS_MADVISE, uintptr(pidfd), uintptr(_p0), uintptr(len(iovs)), uintptr(advice), uintptr(flags), 0)
+	n = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func ProcessMrelease(pidfd int, flags uint) (err error) {
+	_, _, e1 := Syscall(SYS_PROCESS_MRELEASE, uintptr(pidfd), uintptr(flags), 0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
 func shmat(id int, addr uintptr, flag int) (ret uintptr, err error) {
 	r0, _, e1 := Syscall(SYS_SHMAT, uintptr(id), uintptr(addr), uintptr(flag))
 	ret = uintptr(r0)
@@ -2168,6 +2211,166 @@ func shmget(key int, size int, flag int) (id int, err error) {
 
 // THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
 
+func semget(key int, nsems int, flag int) (id int, err error) {
+	r0, _, e1 := Syscall(SYS_SEMGET, uintptr(key), uintptr(nsems), uintptr(flag))
+	id = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func semctl(id int, num int, cmd int, arg uintptr) (result int, err error) {
+	r0, _, e1 := Syscall6(SYS_SEMCTL, uintptr(id), uintptr(num), uintptr(cmd), uintptr(arg), 0, 0)
+	result = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func semctlPtr(id int, num int, cmd int, arg unsafe.Pointer) (result int, err error) {
+	r0, _, e1 := Syscall6(SYS_SEMCTL, uintptr(id), uintptr(num), uintptr(cmd), uintptr(arg), 0, 0)
+	result = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func msgget(key int, flag int) (id int, err error) {
+	r0, _, e1 := Syscall(SYS_MSGGET, uintptr(key), uintptr(flag), 0)
+	id = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func msgctl(id int, cmd int, buf *SysvMsqDesc) (result int, err error) {
+	r0, _, e1 := Syscall(SYS_MSGCTL, uintptr(id), uintptr(cmd), uintptr(unsafe.Pointer(buf)))
+	result = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func msgsnd(id int, msgp unsafe.Pointer, size uintptr, flag int) (err error) {
+	_, _, e1 := Syscall6(SYS_MSGSND, uintptr(id), uintptr(msgp), uintptr(size), uintptr(flag), 0, 0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func msgrcv(id int, msgp unsafe.Pointer, size uintptr, typ int, flag int) (n int, err error) {
+	r0, _, e1 := Syscall6(SYS_MSGRCV, uintptr(id), uintptr(msgp), uintptr(size), uintptr(typ), uintptr(flag), 0)
+	n = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func mqOpen(name string, flag int, mode uint32, attr *MqAttr) (mqd int, err error) {
+	var _p0 *byte
+	_p0, err = BytePtrFromString(name)
+	if err != nil {
+		return
+	}
+	r0, _, e1 := Syscall6(SYS_MQ_OPEN, uintptr(unsafe.Pointer(_p0)), uintptr(flag), uintptr(mode), uintptr(unsafe.Pointer(attr)), 0, 0)
+	mqd = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func mqUnlink(name string) (err error) {
+	var _p0 *byte
+	_p0, err = BytePtrFromString(name)
+	if err != nil {
+		return
+	}
+	_, _, e1 := Syscall(SYS_MQ_UNLINK, uintptr(unsafe.Pointer(_p0)), 0, 0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func MqTimedsend(mqd int, msg []byte, prio uint32, timeout *Timespec) (err error) {
+	var _p0 unsafe.Pointer
+	if len(msg) > 0 {
+		_p0 = unsafe.Pointer(&msg[0])
+	} else {
+		_p0 = unsafe.Pointer(&_zero)
+	}
+	_, _, e1 := Syscall6(SYS_MQ_TIMEDSEND, uintptr(mqd), uintptr(_p0), uintptr(len(msg)), uintptr(prio), uintptr(unsafe.Pointer(timeout)), 0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func MqTimedreceive(mqd int, msg []byte, prio *uint32, timeout *Timespec) (n int, err error) {
+	var _p0 unsafe.Pointer
+	if len(msg) > 0 {
+		_p0 = unsafe.Pointer(&msg[0])
+	} else {
+		_p0 = unsafe.Pointer(&_zero)
+	}
+	r0, _, e1 := Syscall6(SYS_MQ_TIMEDRECEIVE, uintptr(mqd), uintptr(_p0), uintptr(len(msg)), uintptr(unsafe.Pointer(prio)), uintptr(unsafe.Pointer(timeout)), 0)
+	n = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func MqNotify(mqd int, sev *Sigevent) (err error) {
+	_, _, e1 := Syscall(SYS_MQ_NOTIFY, uintptr(mqd), uintptr(unsafe.Pointer(sev)), 0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func MqGetsetattr(mqd int, attr *MqAttr, oldattr *MqAttr) (err error) {
+	_, _, e1 := Syscall(SYS_MQ_GETSETATTR, uintptr(mqd), uintptr(unsafe.Pointer(attr)), uintptr(unsafe.Pointer(oldattr)))
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
 func getitimer(which int, currValue *Itimerval) (err error) {
 	_, _, e1 := Syscall(SYS_GETITIMER, uintptr(which), uintptr(unsafe.Pointer(currValue)), 0)
 	if e1 != 0 {
@@ -2188,6 +2391,57 @@ func setitimer(which int, newValue *Itimerval, oldValue *Itimerval) (err error)
 
 // THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
 
+func timerCreate(clockid int32, sev *Sigevent, timerid *int32) (err error) {
+	_, _, e1 := RawSyscall(SYS_TIMER_CREATE, uintptr(clockid), uintptr(unsafe.Pointer(sev)), uintptr(unsafe.Pointer(timerid)))
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func TimerSettime(timerid int, flags int, newValue *ItimerSpec, oldValue *ItimerSpec) (err error) {
+	_, _, e1 := RawSyscall6(SYS_TIMER_SETTIME, uintptr(timerid), uintptr(flags), uintptr(unsafe.Pointer(newValue)), uintptr(unsafe.Pointer(oldValue)), 0, 0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func TimerGettime(timerid int, currValue *ItimerSpec) (err error) {
+	_, _, e1 := RawSyscall(SYS_TIMER_GETTIME, uintptr(timerid), uintptr(unsafe.Pointer(currValue)), 0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func TimerGetoverrun(timerid int) (overrun int, err error) {
+	r0, _, e1 := RawSyscall(SYS_TIMER_GETOVERRUN, uintptr(timerid), 0, 0)
+	overrun = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func TimerDelete(timerid int) (err error) {
+	_, _, e1 := RawSyscall(SYS_TIMER_DELETE, uintptr(timerid), 0, 0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
 func rtSigprocmask(how int, set *Sigset_t, oldset *Sigset_t, sigsetsize uintptr) (err error) {
 	_, _, e1 := RawSyscall6(SYS_RT_SIGPROCMASK, uintptr(how), uintptr(unsafe.Pointer(set)), uintptr(unsafe.Pointer(oldset)), uintptr(sigsetsize), 0, 0)
 	if e1 != 0 {
@@ -2265,3 +2519,175 @@ func setMemPolicy(mode int, mask unsafe.Pointer, size uintptr) (err error) {
 	}
 	return
 }
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func getMemPolicy(mode *_C_int, mask unsafe.Pointer, maxnode uintptr, addr unsafe.Pointer, flags int) (err error) {
+	_, _, e1 := Syscall6(SYS_GET_MEMPOLICY, uintptr(unsafe.Pointer(mode)), uintptr(mask), uintptr(maxnode), uintptr(addr), uintptr(flags), 0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func mbind(addr unsafe.Pointer, length uintptr, mode int, mask unsafe.Pointer, maxnode uintptr, flags int) (err error) {
+	_, _, e1 := Syscall6(SYS_MBIND, uintptr(addr), uintptr(length), uintptr(mode), uintptr(mask), uintptr(maxnode), uintptr(flags))
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func migratePages(pid int, maxnode uintptr, oldNodes unsafe.Pointer, newNodes unsafe.Pointer) (n int, err error) {
+	r0, _, e1 := Syscall6(SYS_MIGRATE_PAGES, uintptr(pid), uintptr(maxnode), uintptr(oldNodes), uintptr(newNodes), 0, 0)
+	n = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func movePages(pid int, count uintptr, pages unsafe.Pointer, nodes unsafe.Pointer, status unsafe.Pointer, flags int) (err error) {
+	_, _, e1 := Syscall6(SYS_MOVE_PAGES, uintptr(pid), uintptr(count), uintptr(pages), uintptr(nodes), uintptr(status), uintptr(flags))
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func rseq(rseq *Rseq, rseqLen uint32, flags int, sig uint32) (err error) {
+	_, _, e1 := Syscall6(SYS_RSEQ, uintptr(unsafe.Pointer(rseq)), uintptr(rseqLen), uintptr(flags), uintptr(sig), 0, 0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func getcpu(cpu *uint32, node *uint32) (err error) {
+	_, _, e1 := RawSyscall(SYS_GETCPU, uintptr(unsafe.Pointer(cpu)), uintptr(unsafe.Pointer(node)), 0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func Membarrier(cmd int, flags uint, cpuID int) (ret int, err error) {
+	r0, _, e1 := Syscall(SYS_MEMBARRIER, uintptr(cmd), uintptr(flags), uintptr(cpuID))
+	ret = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func PkeyAlloc(flags uint, accessRights uint) (pkey int, err error) {
+	r0, _, e1 := Syscall(SYS_PKEY_ALLOC, uintptr(flags), uintptr(accessRights), 0)
+	pkey = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func PkeyFree(pkey int) (err error) {
+	_, _, e1 := Syscall(SYS_PKEY_FREE, uintptr(pkey), 0, 0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func PkeyMprotect(b []byte, prot int, pkey int) (err error) {
+	var _p0 unsafe.Pointer
+	if len(b) > 0 {
+		_p0 = unsafe.Pointer(&b[0])
+	} else {
+		_p0 = unsafe.Pointer(&_zero)
+	}
+	_, _, e1 := Syscall6(SYS_PKEY_MPROTECT, uintptr(_p0), uintptr(len(b)), uintptr(prot), uintptr(pkey), 0, 0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func IoprioGet(which int, who int) (prio Ioprio, err error) {
+	r0, _, e1 := Syscall(SYS_IOPRIO_GET, uintptr(which), uintptr(who), 0)
+	prio = Ioprio(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func IoprioSet(which int, who int, prio Ioprio) (err error) {
+	_, _, e1 := Syscall(SYS_IOPRIO_SET, uintptr(which), uintptr(who), uintptr(prio))
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func quotactl(cmd int, special *byte, id int, addr unsafe.Pointer) (err error) {
+	_, _, e1 := Syscall6(SYS_QUOTACTL, uintptr(cmd), uintptr(unsafe.Pointer(special)), uintptr(id), uintptr(addr), 0, 0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func QuotactlFd(fd int, cmd int, id int, addr unsafe.Pointer) (err error) {
+	_, _, e1 := Syscall6(SYS_QUOTACTL_FD, uintptr(fd), uintptr(cmd), uintptr(id), uintptr(addr), 0, 0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func Kcmp(pid1 int, pid2 int, typ int, idx1 uintptr, idx2 uintptr) (ret int, err error) {
+	r0, _, e1 := Syscall6(SYS_KCMP, uintptr(pid1), uintptr(pid2), uintptr(typ), uintptr(idx1), uintptr(idx2), 0)
+	ret = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
+func kcmpEpollTfd(pid1 int, pid2 int, typ int, fd1 uintptr, slot *KcmpEpollSlot) (ret int, err error) {
+	r0, _, e1 := Syscall6(SYS_KCMP, uintptr(pid1), uintptr(pid2), uintptr(typ), uintptr(fd1), uintptr(unsafe.Pointer(slot)), 0)
+	ret = int(r0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
@@ -236,6 +236,22 @@ func Renameat(olddirfd int, oldpath string, newdirfd int, newpath string) (err e
 
 // THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
 
+func semtimedopTime64(id int, sops []Sembuf, timeout *KernelTimespec) (err error) {
+	var _p0 unsafe.Pointer
+	if len(sops) > 0 {
+		_p0 = unsafe.Pointer(&sops[0])
+	} else {
+		_p0 = unsafe.Pointer(&_zero)
+	}
+	_, _, e1 := Syscall6(SYS_SEMTIMEDOP_TIME64, uintptr(id), uintptr(_p0), uintptr(len(sops)), uintptr(unsafe.Pointer(timeout)), 0, 0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
 func sendfile(outfd int, infd int, offset *int64, count int) (written int, err error) {
 	r0, _, e1 := Syscall6(SYS_SENDFILE64, uintptr(outfd), uintptr(infd), uintptr(unsafe.Pointer(offset)), uintptr(count), 0, 0)
 	written = int(r0)
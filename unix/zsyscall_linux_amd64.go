@@ -283,6 +283,22 @@ func Seek(fd int, offset int64, whence int) (off int64, err error) {
 
 // THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
 
+func semtimedop(id int, sops []Sembuf, timeout *Timespec) (err error) {
+	var _p0 unsafe.Pointer
+	if len(sops) > 0 {
+		_p0 = unsafe.Pointer(&sops[0])
+	} else {
+		_p0 = unsafe.Pointer(&_zero)
+	}
+	_, _, e1 := Syscall6(SYS_SEMTIMEDOP, uintptr(id), uintptr(_p0), uintptr(len(sops)), uintptr(unsafe.Pointer(timeout)), 0, 0)
+	if e1 != 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+// THIS FILE IS GENERATED BY THE COMMAND AT THE TOP; DO NOT EDIT
+
 func sendfile(outfd int, infd int, offset *int64, count int) (written int, err error) {
 	r0, _, e1 := Syscall6(SYS_SENDFILE, uintptr(outfd), uintptr(infd), uintptr(unsafe.Pointer(offset)), uintptr(count), 0, 0)
 	written = int(r0)
@@ -876,3 +876,54 @@ const (
 	SHM_RDONLY = 0x1000
 	SHM_RND    = 0x2000
 )
+
+type Sembuf struct {
+	Num uint16
+	Op  int16
+	Flg int16
+}
+type SysvSemDesc struct {
+	Perm  SysvIpcPerm
+	Base  int32
+	Nsems uint16
+	_     [2]byte
+	Otime int64
+	Pad1  int32
+	_     [8]byte
+	Pad2  int32
+	Pad3  [4]int32
+}
+
+const (
+	GETNCNT = 0x3
+	GETPID  = 0x4
+	GETVAL  = 0x5
+	GETALL  = 0x6
+	GETZCNT = 0x7
+	SETVAL  = 0x8
+	SETALL  = 0x9
+
+	SEM_UNDO = 0x1000
+)
+
+type SysvMsqDesc struct {
+	Perm   SysvIpcPerm
+	First  int32
+	Last   int32
+	Cbytes uint64
+	Qnum   uint64
+	Qbytes uint64
+	Lspid  int32
+	Lrpid  int32
+	Stime  int64
+	Pad1   int32
+	_      [8]byte
+	Pad2   int32
+	Ctime  int64
+	Pad3   int32
+	Pad4   [4]int32
+}
+
+const (
+	MSG_NOERROR = 0x1000
+)
@@ -159,6 +159,29 @@ const (
 	FILE_DEDUPE_RANGE_DIFFERS    = 0x1
 )
 
+type Fiemap struct {
+	Start          uint64
+	Length         uint64
+	Flags          uint32
+	Mapped_extents uint32
+	Extent_count   uint32
+	Reserved       uint32
+}
+
+type FiemapExtent struct {
+	Logical    uint64
+	Physical   uint64
+	Length     uint64
+	Reserved64 [2]uint64
+	Flags      uint32
+	Reserved   [3]uint32
+}
+
+const (
+	SizeofFiemap       = 0x20
+	SizeofFiemapExtent = 0x38
+)
+
 type FscryptPolicy struct {
 	Version                   uint8
 	Contents_encryption_mode  uint8
@@ -986,6 +1009,13 @@ type SignalfdSiginfo struct {
 	_         [28]uint8
 }
 
+const (
+	SIGEV_SIGNAL    = 0x0
+	SIGEV_NONE      = 0x1
+	SIGEV_THREAD    = 0x2
+	SIGEV_THREAD_ID = 0x4
+)
+
 type Winsize struct {
 	Row    uint16
 	Col    uint16
@@ -4603,6 +4633,294 @@ const (
 	SHM_RND    = 0x2000
 )
 
+type Sembuf struct {
+	Num uint16
+	Op  int16
+	Flg int16
+}
+
+const (
+	GETPID  = 0xb
+	GETVAL  = 0xc
+	GETALL  = 0xd
+	GETNCNT = 0xe
+	GETZCNT = 0xf
+	SETVAL  = 0x10
+	SETALL  = 0x11
+
+	SEM_UNDO = 0x1000
+)
+
+const (
+	MSG_NOERROR = 0x1000
+	MSG_EXCEPT  = 0x2000
+	MSG_COPY    = 0x4000
+)
+
+type Rseq struct {
+	Cpu_id_start uint32
+	Cpu_id       uint32
+	Rseq_cs      uint64
+	Flags        uint32
+	Node_id      uint32
+	Mm_cid       uint32
+	_            [4]byte
+}
+
+const SizeofRseq = 0x20
+
+const (
+	RSEQ_CPU_ID_UNINITIALIZED       = -0x1
+	RSEQ_CPU_ID_REGISTRATION_FAILED = -0x2
+
+	RSEQ_FLAG_UNREGISTER = 0x1
+
+	RSEQ_CS_FLAG_NO_RESTART_ON_PREEMPT = 0x1
+	RSEQ_CS_FLAG_NO_RESTART_ON_SIGNAL  = 0x2
+	RSEQ_CS_FLAG_NO_RESTART_ON_MIGRATE = 0x4
+)
+
+const (
+	MEMBARRIER_CMD_QUERY                                = 0x0
+	MEMBARRIER_CMD_GLOBAL                               = 0x1
+	MEMBARRIER_CMD_GLOBAL_EXPEDITED                     = 0x2
+	MEMBARRIER_CMD_REGISTER_GLOBAL_EXPEDITED            = 0x4
+	MEMBARRIER_CMD_PRIVATE_EXPEDITED                    = 0x8
+	MEMBARRIER_CMD_REGISTER_PRIVATE_EXPEDITED           = 0x10
+	MEMBARRIER_CMD_PRIVATE_EXPEDITED_SYNC_CORE          = 0x20
+	MEMBARRIER_CMD_REGISTER_PRIVATE_EXPEDITED_SYNC_CORE = 0x40
+	MEMBARRIER_CMD_PRIVATE_EXPEDITED_RSEQ               = 0x80
+	MEMBARRIER_CMD_REGISTER_PRIVATE_EXPEDITED_RSEQ      = 0x100
+	MEMBARRIER_CMD_SHARED                               = 0x1
+
+	MEMBARRIER_CMD_FLAG_CPU = 0x1
+)
+
+const (
+	IOPRIO_CLASS_SHIFT = 0xd
+	IOPRIO_NR_CLASSES  = 0x8
+	IOPRIO_CLASS_MASK  = 0x7
+	IOPRIO_PRIO_MASK   = 0x1fff
+
+	IOPRIO_CLASS_NONE    = 0x0
+	IOPRIO_CLASS_RT      = 0x1
+	IOPRIO_CLASS_BE      = 0x2
+	IOPRIO_CLASS_IDLE    = 0x3
+	IOPRIO_CLASS_INVALID = 0x7
+
+	IOPRIO_LEVEL_NR_BITS = 0x3
+	IOPRIO_NR_LEVELS     = 0x8
+	IOPRIO_LEVEL_MASK    = 0x7
+	IOPRIO_BE_NR         = 0x8
+	IOPRIO_NORM          = 0x4
+	IOPRIO_BE_NORM       = 0x4
+
+	IOPRIO_WHO_PROCESS = 0x1
+	IOPRIO_WHO_PGRP    = 0x2
+	IOPRIO_WHO_USER    = 0x3
+
+	IOPRIO_HINT_SHIFT                = 0x3
+	IOPRIO_HINT_NR_BITS              = 0xa
+	IOPRIO_NR_HINTS                  = 0x400
+	IOPRIO_HINT_MASK                 = 0x3ff
+	IOPRIO_HINT_NONE                 = 0x0
+	IOPRIO_HINT_DEV_DURATION_LIMIT_1 = 0x1
+	IOPRIO_HINT_DEV_DURATION_LIMIT_2 = 0x2
+	IOPRIO_HINT_DEV_DURATION_LIMIT_3 = 0x3
+	IOPRIO_HINT_DEV_DURATION_LIMIT_4 = 0x4
+	IOPRIO_HINT_DEV_DURATION_LIMIT_5 = 0x5
+	IOPRIO_HINT_DEV_DURATION_LIMIT_6 = 0x6
+	IOPRIO_HINT_DEV_DURATION_LIMIT_7 = 0x7
+)
+
+type KcmpEpollSlot struct {
+	Efd  uint32
+	Tfd  uint32
+	Toff uint32
+}
+
+const (
+	KCMP_FILE      = 0x0
+	KCMP_VM        = 0x1
+	KCMP_FILES     = 0x2
+	KCMP_FS        = 0x3
+	KCMP_SIGHAND   = 0x4
+	KCMP_IO        = 0x5
+	KCMP_SYSVSEM   = 0x6
+	KCMP_EPOLL_TFD = 0x7
+)
+
+const (
+	AT_NULL              = 0x0
+	AT_IGNORE            = 0x1
+	AT_EXECFD            = 0x2
+	AT_PHDR              = 0x3
+	AT_PHENT             = 0x4
+	AT_PHNUM             = 0x5
+	AT_PAGESZ            = 0x6
+	AT_BASE              = 0x7
+	AT_FLAGS             = 0x8
+	AT_ENTRY             = 0x9
+	AT_NOTELF            = 0xa
+	AT_UID               = 0xb
+	AT_EUID              = 0xc
+	AT_GID               = 0xd
+	AT_EGID              = 0xe
+	AT_PLATFORM          = 0xf
+	AT_HWCAP             = 0x10
+	AT_CLKTCK            = 0x11
+	AT_SECURE            = 0x17
+	AT_BASE_PLATFORM     = 0x18
+	AT_RANDOM            = 0x19
+	AT_HWCAP2            = 0x1a
+	AT_RSEQ_FEATURE_SIZE = 0x1b
+	AT_RSEQ_ALIGN        = 0x1c
+	AT_HWCAP3            = 0x1d
+	AT_HWCAP4            = 0x1e
+	AT_EXECFN            = 0x1f
+	AT_SYSINFO_EHDR      = 0x21
+	AT_MINSIGSTKSZ       = 0x33
+)
+
+type Nextdqblk struct {
+	Bhardlimit uint64
+	Bsoftlimit uint64
+	Curspace   uint64
+	Ihardlimit uint64
+	Isoftlimit uint64
+	Curinodes  uint64
+	Btime      uint64
+	Itime      uint64
+	Valid      uint32
+	Id         uint32
+}
+
+type Dqinfo struct {
+	Bgrace uint64
+	Igrace uint64
+	Flags  uint32
+	Valid  uint32
+}
+
+type FsDiskQuota struct {
+	Version       int8
+	Flags         int8
+	Fieldmask     uint16
+	Id            uint32
+	Blk_hardlimit uint64
+	Blk_softlimit uint64
+	Ino_hardlimit uint64
+	Ino_softlimit uint64
+	Bcount        uint64
+	Icount        uint64
+	Itimer        int32
+	Btimer        int32
+	Iwarns        uint16
+	Bwarns        uint16
+	Itimer_hi     int8
+	Btimer_hi     int8
+	Rtbtimer_hi   int8
+	_             int8
+	Rtb_hardlimit uint64
+	Rtb_softlimit uint64
+	Rtbcount      uint64
+	Rtbtimer      int32
+	Rtbwarns      uint16
+	_             int16
+	_             [8]int8
+}
+
+const (
+	USRQUOTA = 0x0
+	GRPQUOTA = 0x1
+	PRJQUOTA = 0x2
+
+	SUBCMDMASK  = 0xff
+	SUBCMDSHIFT = 0x8
+
+	Q_SYNC         = 0x800001
+	Q_QUOTAON      = 0x800002
+	Q_QUOTAOFF     = 0x800003
+	Q_GETFMT       = 0x800004
+	Q_GETINFO      = 0x800005
+	Q_SETINFO      = 0x800006
+	Q_GETQUOTA     = 0x800007
+	Q_SETQUOTA     = 0x800008
+	Q_GETNEXTQUOTA = 0x800009
+
+	QFMT_VFS_OLD = 0x1
+	QFMT_VFS_V0  = 0x2
+	QFMT_OCFS2   = 0x3
+	QFMT_VFS_V1  = 0x4
+	QFMT_SHMEM   = 0x5
+
+	QIF_DQBLKSIZE_BITS = 0xa
+	QIF_DQBLKSIZE      = 0x400
+	QIF_BLIMITS        = 0x1
+	QIF_SPACE          = 0x2
+	QIF_ILIMITS        = 0x4
+	QIF_INODES         = 0x8
+	QIF_BTIME          = 0x10
+	QIF_ITIME          = 0x20
+	QIF_LIMITS         = 0x5
+	QIF_USAGE          = 0xa
+	QIF_TIMES          = 0x30
+	QIF_ALL            = 0x3f
+
+	IIF_BGRACE = 0x1
+	IIF_IGRACE = 0x2
+	IIF_FLAGS  = 0x4
+	IIF_ALL    = 0x7
+
+	DQF_ROOT_SQUASH = 0x1
+	DQF_SYS_FILE    = 0x10000
+
+	Q_XQUOTAON      = 0x5801
+	Q_XQUOTAOFF     = 0x5802
+	Q_XGETQUOTA     = 0x5803
+	Q_XSETQLIM      = 0x5804
+	Q_XGETQSTAT     = 0x5805
+	Q_XQUOTARM      = 0x5806
+	Q_XQUOTASYNC    = 0x5807
+	Q_XGETQSTATV    = 0x5808
+	Q_XGETNEXTQUOTA = 0x5809
+
+	FS_DQUOT_VERSION = 0x1
+	FS_QSTAT_VERSION = 0x1
+
+	FS_USER_QUOTA  = 0x1
+	FS_PROJ_QUOTA  = 0x2
+	FS_GROUP_QUOTA = 0x4
+
+	FS_DQ_ISOFT      = 0x1
+	FS_DQ_IHARD      = 0x2
+	FS_DQ_BSOFT      = 0x4
+	FS_DQ_BHARD      = 0x8
+	FS_DQ_RTBSOFT    = 0x10
+	FS_DQ_RTBHARD    = 0x20
+	FS_DQ_LIMIT_MASK = 0x3f
+	FS_DQ_BTIMER     = 0x40
+	FS_DQ_ITIMER     = 0x80
+	FS_DQ_RTBTIMER   = 0x100
+	FS_DQ_TIMER_MASK = 0x1c0
+	FS_DQ_BWARNS     = 0x200
+	FS_DQ_IWARNS     = 0x400
+	FS_DQ_RTBWARNS   = 0x800
+	FS_DQ_WARNS_MASK = 0xe00
+	FS_DQ_BCOUNT     = 0x1000
+	FS_DQ_ICOUNT     = 0x2000
+	FS_DQ_RTBCOUNT   = 0x4000
+	FS_DQ_ACCT_MASK  = 0x7000
+	FS_DQ_BIGTIME    = 0x8000
+
+	FS_QUOTA_UDQ_ACCT = 0x1
+	FS_QUOTA_UDQ_ENFD = 0x2
+	FS_QUOTA_GDQ_ACCT = 0x4
+	FS_QUOTA_GDQ_ENFD = 0x8
+	FS_QUOTA_PDQ_ACCT = 0x10
+	FS_QUOTA_PDQ_ENFD = 0x20
+)
+
 type MountAttr struct {
 	Attr_set    uint64
 	Attr_clr    uint64
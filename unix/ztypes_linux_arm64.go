@@ -261,6 +261,14 @@ type Siginfo struct {
 	_     [112]byte
 }
 
+type Sigevent struct {
+	Value  int64
+	Signo  int32
+	Notify int32
+	Tid    int32
+	_      [11]int32
+}
+
 type Termios struct {
 	Iflag  uint32
 	Oflag  uint32
@@ -716,6 +724,72 @@ type SysvShmDesc struct {
 	_      uint64
 }
 
+type SysvSemDesc struct {
+	Perm  SysvIpcPerm
+	Otime int64
+	Ctime int64
+	Nsems uint64
+	_     uint64
+	_     uint64
+}
+
+type SysvMsqDesc struct {
+	Perm   SysvIpcPerm
+	Stime  int64
+	Rtime  int64
+	Ctime  int64
+	Cbytes uint64
+	Qnum   uint64
+	Qbytes uint64
+	Lspid  int32
+	Lrpid  int32
+	_      uint64
+	_      uint64
+}
+
+type MqAttr struct {
+	Flags   int64
+	Maxmsg  int64
+	Msgsize int64
+	Curmsgs int64
+	_       [4]int64
+}
+
+type Dqblk struct {
+	Bhardlimit uint64
+	Bsoftlimit uint64
+	Curspace   uint64
+	Ihardlimit uint64
+	Isoftlimit uint64
+	Curinodes  uint64
+	Btime      uint64
+	Itime      uint64
+	Valid      uint32
+	_          [4]byte
+}
+
+type FsQfilestat struct {
+	Ino      uint64
+	Nblks    uint64
+	Nextents uint32
+	_        [4]byte
+}
+
+type FsQuotaStat struct {
+	Version      int8
+	Flags        uint16
+	_            int8
+	Uquota       FsQfilestat
+	Gquota       FsQfilestat
+	Incoredqs    uint32
+	Btimelimit   int32
+	Itimelimit   int32
+	Rtbtimelimit int32
+	Bwarnlimit   uint16
+	Iwarnlimit   uint16
+	_            [4]byte
+}
+
 const (
 	GPIO_GET_CHIPINFO_IOCTL = 0x8044b401
 )
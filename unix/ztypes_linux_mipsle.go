@@ -262,6 +262,14 @@ type Siginfo struct {
 	_     [116]byte
 }
 
+type Sigevent struct {
+	Value  int32
+	Signo  int32
+	Notify int32
+	Tid    int32
+	_      [12]int32
+}
+
 type Termios struct {
 	Iflag  uint32
 	Oflag  uint32
@@ -722,6 +730,75 @@ type SysvShmDesc struct {
 	_          uint16
 }
 
+type SysvSemDesc struct {
+	Perm       SysvIpcPerm
+	Otime      uint32
+	Ctime      uint32
+	Nsems      uint32
+	Otime_high uint32
+	Ctime_high uint32
+}
+
+type SysvMsqDesc struct {
+	Perm       SysvIpcPerm
+	Stime      uint32
+	Stime_high uint32
+	Rtime      uint32
+	Rtime_high uint32
+	Ctime      uint32
+	Ctime_high uint32
+	Cbytes     uint32
+	Qnum       uint32
+	Qbytes     uint32
+	Lspid      int32
+	Lrpid      int32
+	_          uint32
+	_          uint32
+}
+
+type MqAttr struct {
+	Flags   int32
+	Maxmsg  int32
+	Msgsize int32
+	Curmsgs int32
+	_       [4]int32
+}
+
+type Dqblk struct {
+	Bhardlimit uint64
+	Bsoftlimit uint64
+	Curspace   uint64
+	Ihardlimit uint64
+	Isoftlimit uint64
+	Curinodes  uint64
+	Btime      uint64
+	Itime      uint64
+	Valid      uint32
+	_          [4]byte
+}
+
+type FsQfilestat struct {
+	Ino      uint64
+	Nblks    uint64
+	Nextents uint32
+	_        [4]byte
+}
+
+type FsQuotaStat struct {
+	Version      int8
+	Flags        uint16
+	_            int8
+	Uquota       FsQfilestat
+	Gquota       FsQfilestat
+	Incoredqs    uint32
+	Btimelimit   int32
+	Itimelimit   int32
+	Rtbtimelimit int32
+	Bwarnlimit   uint16
+	Iwarnlimit   uint16
+	_            [4]byte
+}
+
 const (
 	GPIO_GET_CHIPINFO_IOCTL = 0x4044b401
 )
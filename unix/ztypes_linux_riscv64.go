@@ -289,6 +289,14 @@ type Siginfo struct {
 	_     [112]byte
 }
 
+type Sigevent struct {
+	Value  int64
+	Signo  int32
+	Notify int32
+	Tid    int32
+	_      [11]int32
+}
+
 type Termios struct {
 	Iflag  uint32
 	Oflag  uint32
@@ -744,64 +752,169 @@ type SysvShmDesc struct {
 	_      uint64
 }
 
+type SysvSemDesc struct {
+	Perm  SysvIpcPerm
+	Otime int64
+	Ctime int64
+	Nsems uint64
+	_     uint64
+	_     uint64
+}
+
+type SysvMsqDesc struct {
+	Perm   SysvIpcPerm
+	Stime  int64
+	Rtime  int64
+	Ctime  int64
+	Cbytes uint64
+	Qnum   uint64
+	Qbytes uint64
+	Lspid  int32
+	Lrpid  int32
+	_      uint64
+	_      uint64
+}
+
+type MqAttr struct {
+	Flags   int64
+	Maxmsg  int64
+	Msgsize int64
+	Curmsgs int64
+	_       [4]int64
+}
+
+type Dqblk struct {
+	Bhardlimit uint64
+	Bsoftlimit uint64
+	Curspace   uint64
+	Ihardlimit uint64
+	Isoftlimit uint64
+	Curinodes  uint64
+	Btime      uint64
+	Itime      uint64
+	Valid      uint32
+	_          [4]byte
+}
+
+type FsQfilestat struct {
+	Ino      uint64
+	Nblks    uint64
+	Nextents uint32
+	_        [4]byte
+}
+
+type FsQuotaStat struct {
+	Version      int8
+	Flags        uint16
+	_            int8
+	Uquota       FsQfilestat
+	Gquota       FsQfilestat
+	Incoredqs    uint32
+	Btimelimit   int32
+	Itimelimit   int32
+	Rtbtimelimit int32
+	Bwarnlimit   uint16
+	Iwarnlimit   uint16
+	_            [4]byte
+}
+
 type RISCVHWProbePairs struct {
 	Key   int64
 	Value uint64
 }
 
 const (
-	RISCV_HWPROBE_KEY_MVENDORID          = 0x0
-	RISCV_HWPROBE_KEY_MARCHID            = 0x1
-	RISCV_HWPROBE_KEY_MIMPID             = 0x2
-	RISCV_HWPROBE_KEY_BASE_BEHAVIOR      = 0x3
-	RISCV_HWPROBE_BASE_BEHAVIOR_IMA      = 0x1
-	RISCV_HWPROBE_KEY_IMA_EXT_0          = 0x4
-	RISCV_HWPROBE_IMA_FD                 = 0x1
-	RISCV_HWPROBE_IMA_C                  = 0x2
-	RISCV_HWPROBE_IMA_V                  = 0x4
-	RISCV_HWPROBE_EXT_ZBA                = 0x8
-	RISCV_HWPROBE_EXT_ZBB                = 0x10
-	RISCV_HWPROBE_EXT_ZBS                = 0x20
-	RISCV_HWPROBE_EXT_ZICBOZ             = 0x40
-	RISCV_HWPROBE_EXT_ZBC                = 0x80
-	RISCV_HWPROBE_EXT_ZBKB               = 0x100
-	RISCV_HWPROBE_EXT_ZBKC               = 0x200
-	RISCV_HWPROBE_EXT_ZBKX               = 0x400
-	RISCV_HWPROBE_EXT_ZKND               = 0x800
-	RISCV_HWPROBE_EXT_ZKNE               = 0x1000
-	RISCV_HWPROBE_EXT_ZKNH               = 0x2000
-	RISCV_HWPROBE_EXT_ZKSED              = 0x4000
-	RISCV_HWPROBE_EXT_ZKSH               = 0x8000
-	RISCV_HWPROBE_EXT_ZKT                = 0x10000
-	RISCV_HWPROBE_EXT_ZVBB               = 0x20000
-	RISCV_HWPROBE_EXT_ZVBC               = 0x40000
-	RISCV_HWPROBE_EXT_ZVKB               = 0x80000
-	RISCV_HWPROBE_EXT_ZVKG               = 0x100000
-	RISCV_HWPROBE_EXT_ZVKNED             = 0x200000
-	RISCV_HWPROBE_EXT_ZVKNHA             = 0x400000
-	RISCV_HWPROBE_EXT_ZVKNHB             = 0x800000
-	RISCV_HWPROBE_EXT_ZVKSED             = 0x1000000
-	RISCV_HWPROBE_EXT_ZVKSH              = 0x2000000
-	RISCV_HWPROBE_EXT_ZVKT               = 0x4000000
-	RISCV_HWPROBE_EXT_ZFH                = 0x8000000
-	RISCV_HWPROBE_EXT_ZFHMIN             = 0x10000000
-	RISCV_HWPROBE_EXT_ZIHINTNTL          = 0x20000000
-	RISCV_HWPROBE_EXT_ZVFH               = 0x40000000
-	RISCV_HWPROBE_EXT_ZVFHMIN            = 0x80000000
-	RISCV_HWPROBE_EXT_ZFA                = 0x100000000
-	RISCV_HWPROBE_EXT_ZTSO               = 0x200000000
-	RISCV_HWPROBE_EXT_ZACAS              = 0x400000000
-	RISCV_HWPROBE_EXT_ZICOND             = 0x800000000
-	RISCV_HWPROBE_EXT_ZIHINTPAUSE        = 0x1000000000
-	RISCV_HWPROBE_KEY_CPUPERF_0          = 0x5
-	RISCV_HWPROBE_MISALIGNED_UNKNOWN     = 0x0
-	RISCV_HWPROBE_MISALIGNED_EMULATED    = 0x1
-	RISCV_HWPROBE_MISALIGNED_SLOW        = 0x2
-	RISCV_HWPROBE_MISALIGNED_FAST        = 0x3
-	RISCV_HWPROBE_MISALIGNED_UNSUPPORTED = 0x4
-	RISCV_HWPROBE_MISALIGNED_MASK        = 0x7
-	RISCV_HWPROBE_KEY_ZICBOZ_BLOCK_SIZE  = 0x6
-	RISCV_HWPROBE_WHICH_CPUS             = 0x1
+	RISCV_HWPROBE_KEY_MVENDORID                 = 0x0
+	RISCV_HWPROBE_KEY_MARCHID                   = 0x1
+	RISCV_HWPROBE_KEY_MIMPID                    = 0x2
+	RISCV_HWPROBE_KEY_BASE_BEHAVIOR             = 0x3
+	RISCV_HWPROBE_BASE_BEHAVIOR_IMA             = 0x1
+	RISCV_HWPROBE_KEY_IMA_EXT_0                 = 0x4
+	RISCV_HWPROBE_IMA_FD                        = 0x1
+	RISCV_HWPROBE_IMA_C                         = 0x2
+	RISCV_HWPROBE_IMA_V                         = 0x4
+	RISCV_HWPROBE_EXT_ZBA                       = 0x8
+	RISCV_HWPROBE_EXT_ZBB                       = 0x10
+	RISCV_HWPROBE_EXT_ZBS                       = 0x20
+	RISCV_HWPROBE_EXT_ZICBOZ                    = 0x40
+	RISCV_HWPROBE_EXT_ZBC                       = 0x80
+	RISCV_HWPROBE_EXT_ZBKB                      = 0x100
+	RISCV_HWPROBE_EXT_ZBKC                      = 0x200
+	RISCV_HWPROBE_EXT_ZBKX                      = 0x400
+	RISCV_HWPROBE_EXT_ZKND                      = 0x800
+	RISCV_HWPROBE_EXT_ZKNE                      = 0x1000
+	RISCV_HWPROBE_EXT_ZKNH                      = 0x2000
+	RISCV_HWPROBE_EXT_ZKSED                     = 0x4000
+	RISCV_HWPROBE_EXT_ZKSH                      = 0x8000
+	RISCV_HWPROBE_EXT_ZKT                       = 0x10000
+	RISCV_HWPROBE_EXT_ZVBB                      = 0x20000
+	RISCV_HWPROBE_EXT_ZVBC                      = 0x40000
+	RISCV_HWPROBE_EXT_ZVKB                      = 0x80000
+	RISCV_HWPROBE_EXT_ZVKG                      = 0x100000
+	RISCV_HWPROBE_EXT_ZVKNED                    = 0x200000
+	RISCV_HWPROBE_EXT_ZVKNHA                    = 0x400000
+	RISCV_HWPROBE_EXT_ZVKNHB                    = 0x800000
+	RISCV_HWPROBE_EXT_ZVKSED                    = 0x1000000
+	RISCV_HWPROBE_EXT_ZVKSH                     = 0x2000000
+	RISCV_HWPROBE_EXT_ZVKT                      = 0x4000000
+	RISCV_HWPROBE_EXT_ZFH                       = 0x8000000
+	RISCV_HWPROBE_EXT_ZFHMIN                    = 0x10000000
+	RISCV_HWPROBE_EXT_ZIHINTNTL                 = 0x20000000
+	RISCV_HWPROBE_EXT_ZVFH                      = 0x40000000
+	RISCV_HWPROBE_EXT_ZVFHMIN                   = 0x80000000
+	RISCV_HWPROBE_EXT_ZFA                       = 0x100000000
+	RISCV_HWPROBE_EXT_ZTSO                      = 0x200000000
+	RISCV_HWPROBE_EXT_ZACAS                     = 0x400000000
+	RISCV_HWPROBE_EXT_ZICOND                    = 0x800000000
+	RISCV_HWPROBE_EXT_ZIHINTPAUSE               = 0x1000000000
+	RISCV_HWPROBE_EXT_ZVE32X                    = 0x2000000000
+	RISCV_HWPROBE_EXT_ZVE32F                    = 0x4000000000
+	RISCV_HWPROBE_EXT_ZVE64X                    = 0x8000000000
+	RISCV_HWPROBE_EXT_ZVE64F                    = 0x10000000000
+	RISCV_HWPROBE_EXT_ZVE64D                    = 0x20000000000
+	RISCV_HWPROBE_EXT_ZIMOP                     = 0x40000000000
+	RISCV_HWPROBE_EXT_ZCA                       = 0x80000000000
+	RISCV_HWPROBE_EXT_ZCB                       = 0x100000000000
+	RISCV_HWPROBE_EXT_ZCD                       = 0x200000000000
+	RISCV_HWPROBE_EXT_ZCF                       = 0x400000000000
+	RISCV_HWPROBE_EXT_ZCMOP                     = 0x800000000000
+	RISCV_HWPROBE_EXT_ZAWRS                     = 0x1000000000000
+	RISCV_HWPROBE_EXT_SUPM                      = 0x2000000000000
+	RISCV_HWPROBE_EXT_ZICNTR                    = 0x4000000000000
+	RISCV_HWPROBE_EXT_ZIHPM                     = 0x8000000000000
+	RISCV_HWPROBE_EXT_ZFBFMIN                   = 0x10000000000000
+	RISCV_HWPROBE_EXT_ZVFBFMIN                  = 0x20000000000000
+	RISCV_HWPROBE_EXT_ZVFBFWMA                  = 0x40000000000000
+	RISCV_HWPROBE_EXT_ZICBOM                    = 0x80000000000000
+	RISCV_HWPROBE_EXT_ZAAMO                     = 0x100000000000000
+	RISCV_HWPROBE_EXT_ZALRSC                    = 0x200000000000000
+	RISCV_HWPROBE_EXT_ZABHA                     = 0x400000000000000
+	RISCV_HWPROBE_KEY_CPUPERF_0                 = 0x5
+	RISCV_HWPROBE_MISALIGNED_UNKNOWN            = 0x0
+	RISCV_HWPROBE_MISALIGNED_EMULATED           = 0x1
+	RISCV_HWPROBE_MISALIGNED_SLOW               = 0x2
+	RISCV_HWPROBE_MISALIGNED_FAST               = 0x3
+	RISCV_HWPROBE_MISALIGNED_UNSUPPORTED        = 0x4
+	RISCV_HWPROBE_MISALIGNED_MASK               = 0x7
+	RISCV_HWPROBE_KEY_ZICBOZ_BLOCK_SIZE         = 0x6
+	RISCV_HWPROBE_KEY_HIGHEST_VIRT_ADDRESS      = 0x7
+	RISCV_HWPROBE_KEY_TIME_CSR_FREQ             = 0x8
+	RISCV_HWPROBE_KEY_MISALIGNED_SCALAR_PERF    = 0x9
+	RISCV_HWPROBE_MISALIGNED_SCALAR_UNKNOWN     = 0x0
+	RISCV_HWPROBE_MISALIGNED_SCALAR_EMULATED    = 0x1
+	RISCV_HWPROBE_MISALIGNED_SCALAR_SLOW        = 0x2
+	RISCV_HWPROBE_MISALIGNED_SCALAR_FAST        = 0x3
+	RISCV_HWPROBE_MISALIGNED_SCALAR_UNSUPPORTED = 0x4
+	RISCV_HWPROBE_KEY_MISALIGNED_VECTOR_PERF    = 0xa
+	RISCV_HWPROBE_MISALIGNED_VECTOR_UNKNOWN     = 0x0
+	RISCV_HWPROBE_MISALIGNED_VECTOR_SLOW        = 0x2
+	RISCV_HWPROBE_MISALIGNED_VECTOR_FAST        = 0x3
+	RISCV_HWPROBE_MISALIGNED_VECTOR_UNSUPPORTED = 0x4
+	RISCV_HWPROBE_KEY_VENDOR_EXT_THEAD_0        = 0xb
+	RISCV_HWPROBE_KEY_ZICBOM_BLOCK_SIZE         = 0xc
+	RISCV_HWPROBE_KEY_VENDOR_EXT_SIFIVE_0       = 0xd
+	RISCV_HWPROBE_WHICH_CPUS                    = 0x1
+	RISCV_HWPROBE_VENDOR_EXT_XTHEADVECTOR       = 0x1
 )
 
 const (